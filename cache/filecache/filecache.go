@@ -93,6 +93,11 @@ func NewCache(fs afero.Fs, maxAge time.Duration, pruneAllRootDir string) *Cache
 	}
 }
 
+// MaxAge returns the max age for items in this cache.
+func (c *Cache) MaxAge() time.Duration {
+	return c.maxAge
+}
+
 // lockedFile is a file with a lock that is released on Close.
 type lockedFile struct {
 	afero.File
@@ -231,6 +236,58 @@ func (c *Cache) GetOrCreateBytes(id string, create func() ([]byte, error)) (Item
 	return info, b, nil
 }
 
+// GetOrRevalidate gets the file content with the given id from the cache if
+// it's younger than maxAge, which overrides the max age of the cache (but it
+// cannot enable a disabled cache). A negative maxAge means forever.
+// If not found or expired, revalidate will be invoked with the expired content,
+// if any. It returns the new content to cache, or nil to keep the expired content
+// and mark it as fresh.
+// This method is protected by a named lock using the given id as identifier.
+func (c *Cache) GetOrRevalidate(id string, maxAge time.Duration, revalidate func(stale []byte) ([]byte, error)) (ItemInfo, []byte, error) {
+	id = cleanID(id)
+
+	c.nlocker.Lock(id)
+	defer c.nlocker.Unlock(id)
+
+	info := ItemInfo{Name: id}
+
+	if c.maxAge == 0 {
+		// No caching.
+		b, err := revalidate(nil)
+		return info, b, err
+	}
+
+	var stale []byte
+	if fi, err := c.Fs.Stat(id); err == nil {
+		b, err := afero.ReadFile(c.Fs, id)
+		if err == nil {
+			if maxAge < 0 || time.Since(fi.ModTime()) <= maxAge {
+				return info, b, nil
+			}
+			stale = b
+		}
+	}
+
+	b, err := revalidate(stale)
+	if err != nil {
+		return info, nil, err
+	}
+
+	if b == nil {
+		if stale == nil {
+			return info, nil, errors.New("revalidate returned no content")
+		}
+		// Still valid, update the modification time.
+		b = stale
+	}
+
+	if err := afero.WriteReader(c.Fs, id, bytes.NewReader(b)); err != nil {
+		return info, nil, err
+	}
+
+	return info, b, nil
+}
+
 // GetBytes gets the file content with the given id from the cache, nil if none found.
 func (c *Cache) GetBytes(id string) (ItemInfo, []byte, error) {
 	id = cleanID(id)
@@ -51,6 +51,7 @@ const (
 	cacheKeyAssets      = "assets"
 	cacheKeyModules     = "modules"
 	cacheKeyGetResource = "getresource"
+	cacheKeyIncremental = "incremental"
 )
 
 type Configs map[string]Config
@@ -78,6 +79,7 @@ var defaultCacheConfigs = Configs{
 		MaxAge: -1, // Never expire
 		Dir:    cacheDirProject,
 	},
+	cacheKeyIncremental: defaultCacheConfig,
 }
 
 type Config struct {
@@ -124,6 +126,11 @@ func (f Caches) GetResourceCache() *Cache {
 	return f[cacheKeyGetResource]
 }
 
+// IncrementalCache gets the file cache for the incremental build manifest.
+func (f Caches) IncrementalCache() *Cache {
+	return f[cacheKeyIncremental]
+}
+
 func DecodeConfig(fs afero.Fs, cfg config.Provider) (Configs, error) {
 	c := make(Configs)
 	valid := make(map[string]bool)
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 
 	"github.com/gohugoio/hugo/hugofs"
 
@@ -83,15 +84,7 @@ func (c *Cache) Prune(force bool) (int, error) {
 			return nil
 		}
 
-		shouldRemove := force || c.isExpired(info.ModTime())
-
-		if !shouldRemove && len(c.nlocker.seen) > 0 {
-			// Remove it if it's not been touched/used in the last build.
-			_, seen := c.nlocker.seen[name]
-			shouldRemove = !seen
-		}
-
-		if shouldRemove {
+		if force || c.shouldPrune(name, info) {
 			err := c.Fs.Remove(name)
 			if err == nil {
 				counter++
@@ -109,6 +102,62 @@ func (c *Cache) Prune(force bool) (int, error) {
 	return counter, err
 }
 
+// shouldPrune reports whether the item with the given name is expired or,
+// if the cache usage is tracked, not touched/used in the last build.
+func (c *Cache) shouldPrune(name string, info os.FileInfo) bool {
+	if c.isExpired(info.ModTime()) {
+		return true
+	}
+
+	if len(c.nlocker.seen) > 0 {
+		_, seen := c.nlocker.seen[name]
+		return !seen
+	}
+
+	return false
+}
+
+// PruneDryRun returns the sorted names of the items Prune would remove,
+// keyed by cache name, without removing anything.
+func (c Caches) PruneDryRun() (map[string][]string, error) {
+	m := make(map[string][]string)
+	for k, cache := range c {
+		names, err := cache.pruneDryRun()
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to prune cache %q: %w", k, err)
+		}
+		if len(names) > 0 {
+			m[k] = names
+		}
+	}
+
+	return m, nil
+}
+
+func (c *Cache) pruneDryRun() ([]string, error) {
+	if c.pruneAllRootDir != "" {
+		info, err := c.Fs.Stat(c.pruneAllRootDir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		if c.isExpired(info.ModTime()) {
+			return []string{c.pruneAllRootDir}, nil
+		}
+		return nil, nil
+	}
+
+	c.nlocker.seenMu.RLock()
+	defer c.nlocker.seenMu.RUnlock()
+
+	return c.collectNames(c.shouldPrune)
+}
+
 func (c *Cache) pruneRootDir(force bool) (int, error) {
 	info, err := c.Fs.Stat(c.pruneAllRootDir)
 	if err != nil {
@@ -124,3 +173,48 @@ func (c *Cache) pruneRootDir(force bool) (int, error) {
 
 	return hugofs.MakeReadableAndRemoveAllModulePkgDir(c.Fs, c.pruneAllRootDir)
 }
+
+// Unused returns the sorted names of the items in this cache not touched in
+// the current build. Like Prune, this requires a full build to be meaningful.
+func (c *Cache) Unused() ([]string, error) {
+	c.nlocker.seenMu.RLock()
+	defer c.nlocker.seenMu.RUnlock()
+
+	if len(c.nlocker.seen) == 0 {
+		// Nothing touched, as in Prune we can't tell what's unused.
+		return nil, nil
+	}
+
+	return c.collectNames(func(name string, info os.FileInfo) bool {
+		_, seen := c.nlocker.seen[name]
+		return !seen
+	})
+}
+
+// collectNames returns the sorted names of the items in this cache matching
+// include.
+func (c *Cache) collectNames(include func(name string, info os.FileInfo) bool) ([]string, error) {
+	var names []string
+
+	err := afero.Walk(c.Fs, "", func(name string, info os.FileInfo, err error) error {
+		if info == nil || info.IsDir() {
+			return nil
+		}
+
+		name = cleanID(name)
+
+		if include(name, info) {
+			names = append(names, name)
+		}
+
+		return nil
+	})
+
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
@@ -15,6 +15,7 @@ package filecache
 
 import (
 	"fmt"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -69,6 +70,10 @@ dir = ":resourceDir/_gen"
 			}
 		}
 
+		dryRun, err := caches.PruneDryRun()
+		c.Assert(err, qt.IsNil)
+		c.Assert(dryRun[name], qt.DeepEquals, []string{"i0", "i1", "i2", "i3", "i4"}, msg)
+
 		count, err := caches.Prune()
 		c.Assert(err, qt.IsNil)
 		c.Assert(count, qt.Equals, 5, msg)
@@ -108,3 +113,52 @@ dir = ":resourceDir/_gen"
 
 	}
 }
+
+func TestUnused(t *testing.T) {
+	t.Parallel()
+
+	c := qt.New(t)
+
+	configStr := `
+resourceDir = "myresources"
+contentDir = "content"
+dataDir = "data"
+i18nDir = "i18n"
+layoutDir = "layouts"
+assetDir = "assets"
+archeTypedir = "archetypes"
+`
+
+	p := newPathsSpec(t, afero.NewMemMapFs(), configStr)
+	caches, err := NewCaches(p)
+	c.Assert(err, qt.IsNil)
+	cache := caches[cacheKeyImages]
+	for _, id := range []string{"a/i1", "i2", "i3"} {
+		cache.GetOrCreateBytes(id, func() ([]byte, error) {
+			return []byte("abc"), nil
+		})
+	}
+
+	unused, err := cache.Unused()
+	c.Assert(err, qt.IsNil)
+	c.Assert(unused, qt.HasLen, 0)
+
+	// A new build touching none of them.
+	caches, err = NewCaches(p)
+	c.Assert(err, qt.IsNil)
+	unused, err = caches[cacheKeyImages].Unused()
+	c.Assert(err, qt.IsNil)
+	c.Assert(unused, qt.HasLen, 0)
+
+	// A new build touching only one of them.
+	caches, err = NewCaches(p)
+	c.Assert(err, qt.IsNil)
+	cache = caches[cacheKeyImages]
+	cache.GetOrCreateBytes("i2", func() ([]byte, error) {
+		return []byte("abc"), nil
+	})
+
+	unused, err = cache.Unused()
+	c.Assert(err, qt.IsNil)
+	c.Assert(unused, qt.DeepEquals, []string{filepath.FromSlash("a/i1"), "i3"})
+}
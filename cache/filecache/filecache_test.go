@@ -293,6 +293,83 @@ func TestFileCacheReadOrCreateErrorInRead(t *testing.T) {
 	c.Assert(err, qt.Equals, ErrFatal)
 }
 
+func TestFileCacheGetOrRevalidate(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	fs := afero.NewMemMapFs()
+	cache := NewCache(fs, -1, "")
+
+	const id = "a32"
+
+	var calls int
+	var gotStale []byte
+	revalidate := func(b []byte) func(stale []byte) ([]byte, error) {
+		return func(stale []byte) ([]byte, error) {
+			calls++
+			gotStale = stale
+			return b, nil
+		}
+	}
+
+	_, b, err := cache.GetOrRevalidate(id, time.Hour, revalidate([]byte("v1")))
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(b), qt.Equals, "v1")
+	c.Assert(gotStale, qt.IsNil)
+
+	// Fresh.
+	_, b, err = cache.GetOrRevalidate(id, time.Hour, revalidate([]byte("v2")))
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(b), qt.Equals, "v1")
+	c.Assert(calls, qt.Equals, 1)
+
+	old := time.Now().Add(-2 * time.Hour)
+	c.Assert(fs.Chtimes(id, old, old), qt.IsNil)
+
+	// Expired, but still valid.
+	_, b, err = cache.GetOrRevalidate(id, time.Hour, revalidate(nil))
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(b), qt.Equals, "v1")
+	c.Assert(string(gotStale), qt.Equals, "v1")
+	c.Assert(calls, qt.Equals, 2)
+
+	// The modification time was updated.
+	_, b, err = cache.GetOrRevalidate(id, time.Hour, revalidate([]byte("v3")))
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(b), qt.Equals, "v1")
+	c.Assert(calls, qt.Equals, 2)
+
+	// Expired and changed.
+	c.Assert(fs.Chtimes(id, old, old), qt.IsNil)
+	_, b, err = cache.GetOrRevalidate(id, time.Hour, revalidate([]byte("v4")))
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(b), qt.Equals, "v4")
+
+	// Never expires.
+	c.Assert(fs.Chtimes(id, old, old), qt.IsNil)
+	_, b, err = cache.GetOrRevalidate(id, -1, revalidate([]byte("v5")))
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(b), qt.Equals, "v4")
+
+	// Errors are not cached.
+	c.Assert(fs.Chtimes(id, old, old), qt.IsNil)
+	_, _, err = cache.GetOrRevalidate(id, time.Hour, func(stale []byte) ([]byte, error) {
+		return nil, errors.New("fail")
+	})
+	c.Assert(err, qt.ErrorMatches, "fail")
+	c.Assert(cache.getString(id), qt.Equals, "v4")
+
+	// Disabled cache.
+	calls = 0
+	cache = NewCache(afero.NewMemMapFs(), 0, "")
+	for i := 0; i < 2; i++ {
+		_, b, err = cache.GetOrRevalidate(id, -1, revalidate([]byte("v6")))
+		c.Assert(err, qt.IsNil)
+		c.Assert(string(b), qt.Equals, "v6")
+	}
+	c.Assert(calls, qt.Equals, 2)
+}
+
 func TestCleanID(t *testing.T) {
 	c := qt.New(t)
 	c.Assert(cleanID(filepath.FromSlash("/a/b//c.txt")), qt.Equals, filepath.FromSlash("a/b/c.txt"))
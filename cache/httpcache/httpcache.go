@@ -0,0 +1,201 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package httpcache provides a file cache for remote resources that
+// revalidates expired entries using their ETag and Last-Modified headers.
+package httpcache
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"time"
+
+	"github.com/gohugoio/hugo/cache/filecache"
+	"github.com/gohugoio/hugo/common/loggers"
+	"github.com/gohugoio/hugo/config"
+)
+
+// Client fetches remote resources through a file cache.
+type Client struct {
+	HTTPClient *http.Client
+	Cache      *filecache.Cache
+
+	// Per URL overrides of the max age of the cache, retry policy etc.
+	Config config.HTTPCache
+
+	// When set, we never hit the network and fail if the resource is not
+	// in the cache, expired or not.
+	Offline bool
+
+	// If set, called before every retry of a failed request.
+	RetryFunc func(url string, retry, statusCode int, err error)
+}
+
+// New creates a new Client with the httpCache and offline settings in cfg.
+// Retries are logged to logger.
+func New(cfg config.Provider, logger loggers.Logger, httpClient *http.Client, cache *filecache.Cache) *Client {
+	hc, ok := cfg.Get("httpCacheConfig").(config.HTTPCache)
+	if !ok {
+		// This is validated when loading the configuration.
+		hc, _ = config.DecodeHTTPCache(cfg)
+	}
+
+	c := &Client{
+		HTTPClient: httpClient,
+		Cache:      cache,
+		Config:     hc,
+		Offline:    cfg.GetBool("offline"),
+	}
+
+	if logger != nil {
+		c.RetryFunc = func(url string, retry, statusCode int, err error) {
+			if err == nil {
+				err = errors.New(http.StatusText(statusCode))
+			}
+			logger.Infof("Retry #%d for %s: %s", retry, url, err)
+		}
+	}
+
+	return c
+}
+
+// Do returns the response for the request created by newRequest, from the
+// cache with the given id if found and not expired.
+// An expired entry is revalidated with a conditional request if it has an
+// ETag or a Last-Modified header.
+// Any new response is passed to validate, if set, before it is cached. If
+// validate returns an error, the response is not cached and the error returned.
+// The returned response's body is already read and returned as a byte slice.
+func (c *Client) Do(id, url string, newRequest func() (*http.Request, error), validate func(res *http.Response) error) (*http.Response, []byte, error) {
+	maxAge := c.Cache.MaxAge()
+	if c.Offline {
+		maxAge = -1
+	} else if d, found := c.Config.MaxAge(url); found {
+		maxAge = d
+	}
+
+	_, b, err := c.Cache.GetOrRevalidate(id, maxAge, func(stale []byte) ([]byte, error) {
+		if c.Offline {
+			return nil, fmt.Errorf("%s is not in the cache and offline is enabled", url)
+		}
+
+		var conditionalHeaders http.Header
+		if stale != nil {
+			if staleRes, _, err := readResponse(stale); err == nil {
+				conditionalHeaders = make(http.Header)
+				if etag := staleRes.Header.Get("ETag"); etag != "" {
+					conditionalHeaders.Set("If-None-Match", etag)
+				}
+				if lastModified := staleRes.Header.Get("Last-Modified"); lastModified != "" {
+					conditionalHeaders.Set("If-Modified-Since", lastModified)
+				}
+			}
+			if len(conditionalHeaders) == 0 {
+				stale = nil
+			}
+		}
+
+		res, err := c.doWithRetry(url, newRequest, conditionalHeaders)
+		if err != nil {
+			return nil, err
+		}
+		defer res.Body.Close()
+
+		if stale != nil && res.StatusCode == http.StatusNotModified {
+			return nil, nil
+		}
+
+		b, err := httputil.DumpResponse(res, true)
+		if err != nil {
+			return nil, err
+		}
+
+		if validate != nil {
+			if err := validate(res); err != nil {
+				return nil, err
+			}
+		}
+
+		return b, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return readResponse(b)
+}
+
+// doWithRetry sends the request created by newRequest, retrying it as
+// configured in Retry.
+func (c *Client) doWithRetry(url string, newRequest func() (*http.Request, error), headers http.Header) (*http.Response, error) {
+	retry := c.Config.Retry
+
+	for attempt := 0; ; attempt++ {
+		req, err := newRequest()
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range headers {
+			req.Header[k] = v
+		}
+
+		res, err := c.HTTPClient.Do(req)
+
+		var statusCode int
+		if err == nil {
+			statusCode = res.StatusCode
+		}
+
+		if !retry.ShouldRetry(attempt, statusCode, err) {
+			if err != nil && attempt > 0 {
+				err = fmt.Errorf("failed after %d attempts: %w", attempt+1, err)
+			}
+			return res, err
+		}
+
+		if res != nil {
+			res.Body.Close()
+		}
+
+		if c.RetryFunc != nil {
+			c.RetryFunc(url, attempt+1, statusCode, err)
+		}
+
+		time.Sleep(retry.Backoff(attempt))
+	}
+}
+
+func readResponse(b []byte) (*http.Response, []byte, error) {
+	if !bytes.HasPrefix(b, []byte("HTTP/")) {
+		// Older versions of Hugo stored the body only.
+		return &http.Response{StatusCode: http.StatusOK, Header: make(http.Header)}, b, nil
+	}
+
+	res, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(b)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res, body, nil
+}
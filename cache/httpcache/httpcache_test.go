@@ -0,0 +1,263 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpcache
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/cache/filecache"
+	"github.com/gohugoio/hugo/config"
+	"github.com/spf13/afero"
+)
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	var requests, downloads int
+	version := "v1"
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		etag := `"` + version + `"`
+		w.Header().Set("ETag", etag)
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		downloads++
+		fmt.Fprintf(w, "content %s", version)
+	}))
+	defer ts.Close()
+
+	fs := afero.NewMemMapFs()
+	const id = "myid"
+
+	newClient := func(configString string, offline bool) *Client {
+		cfg, err := config.FromConfigString(configString, "toml")
+		c.Assert(err, qt.IsNil)
+		cfg.Set("offline", offline)
+		return New(cfg, nil, http.DefaultClient, filecache.NewCache(fs, -1, ""))
+	}
+
+	get := func(client *Client) (string, error) {
+		_, b, err := client.Do(id, ts.URL, func() (*http.Request, error) {
+			return http.NewRequest("GET", ts.URL, nil)
+		}, nil)
+		return string(b), err
+	}
+
+	expire := func() {
+		old := time.Now().Add(-2 * time.Hour)
+		c.Assert(fs.Chtimes(id, old, old), qt.IsNil)
+	}
+
+	// Offline and not in the cache.
+	_, err := get(newClient("", true))
+	c.Assert(err, qt.ErrorMatches, ".*is not in the cache and offline is enabled")
+	c.Assert(requests, qt.Equals, 0)
+
+	// Cache forever.
+	client := newClient("", false)
+	s, err := get(client)
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.Equals, "content v1")
+	s, err = get(client)
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.Equals, "content v1")
+	c.Assert(requests, qt.Equals, 1)
+
+	// With a TTL override, the expired entry is revalidated.
+	client = newClient(fmt.Sprintf(`
+[[httpCache.ttl]]
+for = "%s*"
+maxAge = "1h"
+`, ts.URL), false)
+	s, err = get(client)
+	c.Assert(err, qt.IsNil)
+	c.Assert(requests, qt.Equals, 1)
+	expire()
+	s, err = get(client)
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.Equals, "content v1")
+	c.Assert(requests, qt.Equals, 2)
+	c.Assert(downloads, qt.Equals, 1)
+
+	// Not modified refreshes the entry.
+	s, err = get(client)
+	c.Assert(err, qt.IsNil)
+	c.Assert(requests, qt.Equals, 2)
+
+	// Changed.
+	version = "v2"
+	expire()
+	s, err = get(client)
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.Equals, "content v2")
+	c.Assert(requests, qt.Equals, 3)
+	c.Assert(downloads, qt.Equals, 2)
+
+	// Offline uses expired entries.
+	version = "v3"
+	expire()
+	s, err = get(newClient("", true))
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.Equals, "content v2")
+	c.Assert(requests, qt.Equals, 3)
+}
+
+func TestClientLastModified(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	lastModified := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC).Format(http.TimeFormat)
+	var downloads int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-Modified-Since") == lastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		downloads++
+		w.Header().Set("Last-Modified", lastModified)
+		fmt.Fprint(w, "content")
+	}))
+	defer ts.Close()
+
+	fs := afero.NewMemMapFs()
+	client := New(config.New(), nil, http.DefaultClient, filecache.NewCache(fs, time.Hour, ""))
+
+	for i := 0; i < 3; i++ {
+		_, b, err := client.Do("myid", ts.URL, func() (*http.Request, error) {
+			return http.NewRequest("GET", ts.URL, nil)
+		}, nil)
+		c.Assert(err, qt.IsNil)
+		c.Assert(string(b), qt.Equals, "content")
+		old := time.Now().Add(-2 * time.Hour)
+		c.Assert(fs.Chtimes("myid", old, old), qt.IsNil)
+	}
+
+	c.Assert(downloads, qt.Equals, 1)
+}
+
+func TestClientValidate(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.Error(w, "failed", http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	fs := afero.NewMemMapFs()
+	client := New(config.New(), nil, http.DefaultClient, filecache.NewCache(fs, -1, ""))
+
+	validate := func(res *http.Response) error {
+		if res.StatusCode != http.StatusOK {
+			return errors.New(res.Status)
+		}
+		return nil
+	}
+
+	for i := 0; i < 2; i++ {
+		_, _, err := client.Do("myid", ts.URL, func() (*http.Request, error) {
+			return http.NewRequest("GET", ts.URL, nil)
+		}, validate)
+		c.Assert(err, qt.ErrorMatches, "500 Internal Server Error")
+	}
+
+	c.Assert(requests, qt.Equals, 2)
+}
+
+func TestClientLegacyCacheEntry(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	fs := afero.NewMemMapFs()
+	c.Assert(afero.WriteFile(fs, "myid", []byte(`{"a": 1}`), 0666), qt.IsNil)
+
+	cfg := config.New()
+	cfg.Set("offline", true)
+	client := New(cfg, nil, http.DefaultClient, filecache.NewCache(fs, -1, ""))
+
+	res, b, err := client.Do("myid", "https://example.org/data.json", nil, nil)
+	c.Assert(err, qt.IsNil)
+	c.Assert(res.StatusCode, qt.Equals, http.StatusOK)
+	c.Assert(string(b), qt.Equals, `{"a": 1}`)
+}
+
+func TestClientRetry(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	var requests int
+	failures := 2
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests <= failures {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, "content")
+	}))
+	defer ts.Close()
+
+	newClient := func(attempts int) *Client {
+		cfg, err := config.FromConfigString(fmt.Sprintf(`
+[httpCache.retry]
+attempts = %d
+initialBackoff = "1ms"
+maxBackoff = "2ms"
+`, attempts), "toml")
+		c.Assert(err, qt.IsNil)
+		return New(cfg, nil, http.DefaultClient, filecache.NewCache(afero.NewMemMapFs(), -1, ""))
+	}
+
+	get := func(client *Client) (*http.Response, string, error) {
+		res, b, err := client.Do("myid", ts.URL, func() (*http.Request, error) {
+			return http.NewRequest("GET", ts.URL, nil)
+		}, func(res *http.Response) error {
+			if !client.Config.IsAllowedStatusCode(res.StatusCode) {
+				return errors.New(res.Status)
+			}
+			return nil
+		})
+		return res, string(b), err
+	}
+
+	client := newClient(2)
+	var retries []int
+	client.RetryFunc = func(url string, retry, statusCode int, err error) {
+		c.Assert(statusCode, qt.Equals, http.StatusServiceUnavailable)
+		retries = append(retries, retry)
+	}
+	_, s, err := get(client)
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.Equals, "content")
+	c.Assert(requests, qt.Equals, 3)
+	c.Assert(retries, qt.DeepEquals, []int{1, 2})
+
+	requests = 0
+	_, _, err = get(newClient(1))
+	c.Assert(err, qt.ErrorMatches, "503 Service Unavailable")
+	c.Assert(requests, qt.Equals, 2)
+}
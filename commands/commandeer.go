@@ -475,9 +475,10 @@ func (c *commandeer) loadConfig() error {
 			c.changeDetector = changeDetector
 		}
 
-		if c.Cfg.GetBool("logPathWarnings") {
+		// The publish manifest is built from the files written in this build.
+		if c.Cfg.GetBool("logPathWarnings") || hconfig.DecodeBuild(c.Cfg).WriteManifest || c.h.compare != "" {
 			// Note that we only care about the "dynamic creates" here,
-			// so skip the static fs.
+			// so skip the static fs (see staticDestFs).
 			fs.PublishDir = hugofs.NewCreateCountingFs(fs.PublishDir)
 		}
 
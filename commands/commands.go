@@ -212,7 +212,11 @@ type hugoBuilderCommon struct {
 	poll       string
 	clock      string
 
-	gc bool
+	gc       bool
+	gcDryRun bool
+
+	// Path to a publish manifest to compare the build output with.
+	compare string
 
 	// Profile flags (for debugging of performance problems)
 	cpuprofile   string
@@ -293,11 +297,14 @@ func (cc *hugoBuilderCommon) handleFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("layoutDir", "l", "", "filesystem path to layout directory")
 	cmd.Flags().StringP("cacheDir", "", "", "filesystem path to cache directory. Defaults: $TMPDIR/hugo_cache/")
 	cmd.Flags().BoolP("ignoreCache", "", false, "ignores the cache directory")
+	cmd.Flags().Bool("offline", false, "never fetch remote resources, fail if they are not found in the cache")
 	cmd.Flags().StringP("destination", "d", "", "filesystem path to write files to")
 	cmd.Flags().StringSliceP("theme", "t", []string{}, "themes to use (located in /themes/THEMENAME/)")
 	cmd.Flags().StringVarP(&cc.baseURL, "baseURL", "b", "", "hostname (and path) to the root, e.g. https://spf13.com/")
 	cmd.Flags().Bool("enableGitInfo", false, "add Git revision, date, author, and CODEOWNERS info to the pages")
 	cmd.Flags().BoolVar(&cc.gc, "gc", false, "enable to run some cleanup tasks (remove unused cache files) after the build")
+	cmd.Flags().BoolVar(&cc.gcDryRun, "gcDryRun", false, "list the cache files --gc would remove without removing them")
+	cmd.Flags().StringVar(&cc.compare, "compare", "", "compare the build output with the `manifest` written by a previous build with build.writeManifest and report the differences")
 	cmd.Flags().StringVar(&cc.poll, "poll", "", "set this to a poll interval, e.g --poll 700ms, to use a poll based approach to watch for file system changes")
 	cmd.Flags().BoolVar(&loggers.PanicOnWarning, "panicOnWarning", false, "panic on first WARNING log")
 	cmd.Flags().Bool("templateMetrics", false, "display metrics about template executions")
@@ -306,9 +313,13 @@ func (cc *hugoBuilderCommon) handleFlags(cmd *cobra.Command) {
 	cmd.Flags().BoolP("noTimes", "", false, "don't sync modification time of files")
 	cmd.Flags().BoolP("noChmod", "", false, "don't sync permission mode of files")
 	cmd.Flags().BoolP("noBuildLock", "", false, "don't create .hugo_build.lock file")
+	cmd.Flags().Bool("incremental", false, "skip rendering the regular pages when no content, template or other input changed since the last incremental build; ignored with build.writeStats, build.writeManifest, --compare, --gc, --gcDryRun and --printUnusedImages")
 	cmd.Flags().BoolP("printI18nWarnings", "", false, "print missing translations")
 	cmd.Flags().BoolP("printPathWarnings", "", false, "print warnings on duplicate target paths etc.")
 	cmd.Flags().BoolP("printUnusedTemplates", "", false, "print warnings on unused templates.")
+	cmd.Flags().BoolP("printImageStats", "", false, "print image processing stats (count, cache hits, bytes read and written).")
+	cmd.Flags().BoolP("printUnusedImages", "", false, "print warnings on processed images in the image cache not used in this build.")
+	cmd.Flags().BoolP("printSocialWarnings", "", false, "print warnings on pages with missing or poor social media preview meta tags.")
 	cmd.Flags().StringVarP(&cc.cpuprofile, "profile-cpu", "", "", "write cpu profile to `file`")
 	cmd.Flags().StringVarP(&cc.memprofile, "profile-mem", "", "", "write memory profile to `file`")
 	cmd.Flags().BoolVarP(&cc.printm, "printMemoryUsage", "", false, "print memory usage to screen at intervals")
@@ -186,6 +186,7 @@ func TestFlags(t *testing.T) {
 				"--renderToDisk",
 				"--source=mysource",
 				"--printPathWarnings",
+				"--printSocialWarnings",
 				"--printUnusedTemplates",
 			},
 			check: func(c *qt.C, sc *serverCmd) {
@@ -215,6 +216,9 @@ func TestFlags(t *testing.T) {
 
 				// The flag is named printI18nWarnings
 				c.Assert(cfg.GetBool("logI18nWarnings"), qt.Equals, true)
+
+				// The flag is named printSocialWarnings
+				c.Assert(cfg.GetBool("logSocialWarnings"), qt.Equals, true)
 			},
 		},
 	}
@@ -269,6 +273,9 @@ func TestCommandsExecute(t *testing.T) {
 		{[]string{"gen", "chromastyles"}, []string{"--style=manni"}, ""},
 		{[]string{"gen", "doc"}, []string{"--dir=" + filepath.Join(dirOut, "doc")}, ""},
 		{[]string{"gen", "man"}, []string{"--dir=" + filepath.Join(dirOut, "man")}, ""},
+		{[]string{"gen", "testsite"}, []string{"--dir=" + filepath.Join(dirOut, "testsite"), "--sections=2", "--pages=4", "--languages=2"}, ""},
+		{nil, []string{"-s=" + filepath.Join(dirOut, "testsite")}, ""},
+		{[]string{"gen", "testsite"}, []string{"--dir=" + filepath.Join(dirOut, "testsite")}, "already exists and is not empty"},
 		{[]string{"list", "drafts"}, []string{sourceFlag}, ""},
 		{[]string{"list", "expired"}, []string{sourceFlag}, ""},
 		{[]string{"list", "future"}, []string{sourceFlag}, ""},
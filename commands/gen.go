@@ -34,7 +34,8 @@ func newGenCmd() *genCmd {
 		newGenDocCmd().getCommand(),
 		newGenManCmd().getCommand(),
 		createGenDocsHelper().getCommand(),
-		createGenChromaStyles().getCommand())
+		createGenChromaStyles().getCommand(),
+		createGenTestSiteCmd().getCommand())
 
 	return cc
 }
@@ -0,0 +1,304 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"math/rand"
+	"path/filepath"
+	"strings"
+
+	"github.com/gohugoio/hugo/helpers"
+	"github.com/gohugoio/hugo/hugofs"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	jww "github.com/spf13/jwalterweatherman"
+)
+
+var _ cmder = (*genTestSiteCmd)(nil)
+
+type genTestSiteCmd struct {
+	dir       string
+	sections  int
+	pages     int
+	languages int
+	tags      int
+	bundles   bool
+	seed      int64
+	force     bool
+
+	*baseCmd
+}
+
+// The languages used in the generated site, the first is the default.
+var testSiteLanguages = []string{"en", "nn", "fr", "de", "es", "it", "sv", "da"}
+
+func createGenTestSiteCmd() *genTestSiteCmd {
+	cc := &genTestSiteCmd{}
+
+	cc.baseCmd = newBaseCmd(&cobra.Command{
+		Use:   "testsite",
+		Short: "Generate a synthetic site for benchmarks and bug reports",
+		Long: `Generate a synthetic site with a configurable number of sections and pages,
+with tags and categories, page bundles and translations.
+
+This is useful to benchmark Hugo with a site of a given size, and to
+reproduce scaling issues in bug reports without sharing a real site.
+The content is the same for the same flags.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cc.generate()
+		},
+	})
+
+	cc.cmd.Flags().StringVar(&cc.dir, "dir", "testsite", "the directory to write the site to")
+	cc.cmd.Flags().IntVar(&cc.sections, "sections", 10, "the number of sections")
+	cc.cmd.Flags().IntVar(&cc.pages, "pages", 100, "the number of pages in each section")
+	cc.cmd.Flags().IntVar(&cc.languages, "languages", 1, fmt.Sprintf("the number of languages, max %d", len(testSiteLanguages)))
+	cc.cmd.Flags().IntVar(&cc.tags, "tags", 50, "the number of tags to spread the pages on")
+	cc.cmd.Flags().BoolVar(&cc.bundles, "bundles", true, "make every third page a bundle with an image and a data file")
+	cc.cmd.Flags().Int64Var(&cc.seed, "seed", 1, "the seed used to pick tags and content")
+	cc.cmd.Flags().BoolVar(&cc.force, "force", false, "write into a non-empty directory")
+
+	_ = cc.cmd.MarkFlagDirname("dir")
+
+	return cc
+}
+
+func (cc *genTestSiteCmd) generate() error {
+	if cc.languages < 1 || cc.languages > len(testSiteLanguages) {
+		return fmt.Errorf("languages must be between 1 and %d", len(testSiteLanguages))
+	}
+	if cc.sections < 0 || cc.pages < 0 || cc.tags < 1 {
+		return errors.New("sections and pages must be positive, and there must be at least one tag")
+	}
+
+	fs := hugofs.Os
+
+	if exists, _ := helpers.Exists(cc.dir, fs); exists {
+		isEmpty, _ := helpers.IsEmpty(cc.dir, fs)
+		if !isEmpty && !cc.force {
+			return errors.New(cc.dir + " already exists and is not empty. See --force.")
+		}
+	}
+
+	jww.FEEDBACK.Printf("Generating a site with %d sections of %d pages in %d language(s) in %s ...\n", cc.sections, cc.pages, cc.languages, cc.dir)
+
+	g := &testSiteGenerator{
+		genTestSiteCmd: cc,
+		fs:             fs,
+		r:              rand.New(rand.NewSource(cc.seed)),
+	}
+
+	if err := g.generate(); err != nil {
+		return err
+	}
+
+	jww.FEEDBACK.Printf("Done, wrote %d files.\n", g.count)
+
+	return nil
+}
+
+type testSiteGenerator struct {
+	*genTestSiteCmd
+	fs    afero.Fs
+	r     *rand.Rand
+	count int
+}
+
+func (g *testSiteGenerator) generate() error {
+	langs := testSiteLanguages[:g.languages]
+
+	if err := g.writeFile("config.toml", g.config(langs)); err != nil {
+		return err
+	}
+
+	for filename, content := range testSiteLayouts {
+		if err := g.writeFile(filename, content); err != nil {
+			return err
+		}
+	}
+
+	var img bytes.Buffer
+	if g.bundles {
+		if err := png.Encode(&img, testSiteImage()); err != nil {
+			return err
+		}
+	}
+
+	for i := 1; i <= g.sections; i++ {
+		section := fmt.Sprintf("section-%d", i)
+		for _, lang := range langs {
+			if err := g.writeFile(g.contentFilename(lang, section, "_index"), g.frontMatter(fmt.Sprintf("Section %d", i), i, nil, nil)); err != nil {
+				return err
+			}
+		}
+
+		for j := 1; j <= g.pages; j++ {
+			name := fmt.Sprintf("page-%d", j)
+			isBundle := g.bundles && j%3 == 0
+			if isBundle {
+				name = filepath.Join(name, "index")
+			}
+
+			tags := g.pick("tag", g.tags, 3)
+			categories := g.pick("category", 5, 1)
+
+			for _, lang := range langs {
+				content := g.frontMatter(fmt.Sprintf("Page %d in section %d", j, i), j, tags, categories) + g.paragraphs(3)
+				if err := g.writeFile(g.contentFilename(lang, section, name), content); err != nil {
+					return err
+				}
+			}
+
+			if isBundle {
+				dir := filepath.Join("content", section, filepath.Dir(name))
+				if err := g.writeFile(filepath.Join(dir, "image.png"), img.String()); err != nil {
+					return err
+				}
+				if err := g.writeFile(filepath.Join(dir, "data.json"), fmt.Sprintf(`{"section": %d, "page": %d}`, i, j)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *testSiteGenerator) config(langs []string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, `baseURL = "https://example.org/"
+title = "Hugo Test Site"
+defaultContentLanguage = %q
+paginate = 20
+
+[taxonomies]
+tag = "tags"
+category = "categories"
+`, langs[0])
+
+	if len(langs) > 1 {
+		b.WriteString("\n[languages]\n")
+		for i, lang := range langs {
+			fmt.Fprintf(&b, "[languages.%s]\nweight = %d\ntitle = \"Hugo Test Site (%s)\"\n", lang, i+1, lang)
+		}
+	}
+
+	return b.String()
+}
+
+func (g *testSiteGenerator) contentFilename(lang, section, name string) string {
+	if lang != testSiteLanguages[0] {
+		name += "." + lang
+	}
+	return filepath.Join("content", section, name+".md")
+}
+
+func (g *testSiteGenerator) frontMatter(title string, weight int, tags, categories []string) string {
+	var b strings.Builder
+	b.WriteString("---\n")
+	fmt.Fprintf(&b, "title: %q\n", title)
+	fmt.Fprintf(&b, "weight: %d\n", weight)
+	if len(tags) > 0 {
+		fmt.Fprintf(&b, "tags: [%s]\n", strings.Join(tags, ", "))
+	}
+	if len(categories) > 0 {
+		fmt.Fprintf(&b, "categories: [%s]\n", strings.Join(categories, ", "))
+	}
+	b.WriteString("---\n\n")
+	return b.String()
+}
+
+// pick returns n distinct terms out of max, e.g. "tag-3".
+func (g *testSiteGenerator) pick(prefix string, max, n int) []string {
+	if n > max {
+		n = max
+	}
+	var terms []string
+	for _, i := range g.r.Perm(max)[:n] {
+		terms = append(terms, fmt.Sprintf("%s-%d", prefix, i+1))
+	}
+	return terms
+}
+
+func (g *testSiteGenerator) paragraphs(n int) string {
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		if i == 1 {
+			b.WriteString("## A heading\n\n")
+		}
+		words := testSiteWords[g.r.Intn(len(testSiteWords)/2):]
+		words = words[:len(words)/2+g.r.Intn(len(words)/2)]
+		sentence := strings.Join(words, " ")
+		b.WriteString(strings.ToUpper(sentence[:1]) + sentence[1:])
+		b.WriteString(".\n\n")
+	}
+	return b.String()
+}
+
+func (g *testSiteGenerator) writeFile(filename, content string) error {
+	g.count++
+	return helpers.WriteToDisk(filepath.Join(g.dir, filename), strings.NewReader(content), g.fs)
+}
+
+func testSiteImage() image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, 32, 32))
+	for x := 0; x < 32; x++ {
+		for y := 0; y < 32; y++ {
+			img.Set(x, y, color.RGBA{uint8(x * 8), uint8(y * 8), 128, 255})
+		}
+	}
+	return img
+}
+
+var testSiteWords = strings.Fields(`Lorem ipsum dolor sit amet consectetur adipiscing elit sed do
+eiusmod tempor incididunt ut labore et dolore magna aliqua Ut enim ad minim veniam quis nostrud
+exercitation ullamco laboris nisi ut aliquip ex ea commodo consequat Duis aute irure dolor in
+reprehenderit in voluptate velit esse cillum dolore eu fugiat nulla pariatur Excepteur sint occaecat
+cupidatat non proident sunt in culpa qui officia deserunt mollit anim id est laborum`)
+
+var testSiteLayouts = map[string]string{
+	filepath.Join("layouts", "_default", "baseof.html"): `<!DOCTYPE html>
+<html lang="{{ site.Language.Lang }}">
+<head><title>{{ .Title }} | {{ site.Title }}</title></head>
+<body>
+<nav>{{ range site.Sections }}<a href="{{ .RelPermalink }}">{{ .Title }}</a> {{ end }}</nav>
+{{ block "main" . }}{{ end }}
+</body>
+</html>
+`,
+	filepath.Join("layouts", "_default", "list.html"): `{{ define "main" }}
+<h1>{{ .Title }}</h1>
+{{ .Content }}
+{{ range .Paginator.Pages }}<article><a href="{{ .RelPermalink }}">{{ .Title }}</a>: {{ .Summary }}</article>
+{{ end }}
+{{ template "_internal/pagination.html" . }}
+{{ end }}
+`,
+	filepath.Join("layouts", "_default", "single.html"): `{{ define "main" }}
+<h1>{{ .Title }}</h1>
+{{ with .Resources.GetMatch "*.png" }}{{ with .Resize "16x" }}<img src="{{ .RelPermalink }}" width="{{ .Width }}">{{ end }}{{ end }}
+{{ .TableOfContents }}
+{{ .Content }}
+{{ range .GetTerms "tags" }}<a href="{{ .RelPermalink }}">{{ .LinkTitle }}</a> {{ end }}
+{{ range .Translations }}<a href="{{ .RelPermalink }}">{{ .Language.Lang }}</a> {{ end }}
+{{ with .Site.RegularPages.Related . | first 5 }}<ul>{{ range . }}<li>{{ .Title }}</li>{{ end }}</ul>{{ end }}
+{{ end }}
+`,
+}
@@ -25,6 +25,7 @@ import (
 	"runtime"
 	"runtime/pprof"
 	"runtime/trace"
+	"sort"
 	"strings"
 	"sync/atomic"
 	"syscall"
@@ -203,6 +204,7 @@ func initializeFlags(cmd *cobra.Command, cfg config.Provider) {
 		"pluralizeListTitles",
 		"preserveTaxonomyNames",
 		"ignoreCache",
+		"offline",
 		"forceSyncStatic",
 		"noTimes",
 		"noChmod",
@@ -224,8 +226,13 @@ func initializeFlags(cmd *cobra.Command, cfg config.Provider) {
 		"dryRun",
 		"force",
 		"gc",
+		"gcDryRun",
+		"compare",
+		"incremental",
 		"printI18nWarnings",
 		"printUnusedTemplates",
+		"printImageStats",
+		"printUnusedImages",
 		"invalidateCDN",
 		"layoutDir",
 		"logFile",
@@ -254,6 +261,7 @@ func initializeFlags(cmd *cobra.Command, cfg config.Provider) {
 	setValueFromFlag(cmd.Flags(), "destination", cfg, "publishDir", false)
 	setValueFromFlag(cmd.Flags(), "printI18nWarnings", cfg, "logI18nWarnings", false)
 	setValueFromFlag(cmd.Flags(), "printPathWarnings", cfg, "logPathWarnings", false)
+	setValueFromFlag(cmd.Flags(), "printSocialWarnings", cfg, "logSocialWarnings", false)
 }
 
 func setValueFromFlag(flags *flag.FlagSet, key string, cfg config.Provider, targetKey string, force bool) {
@@ -300,6 +308,20 @@ func (c *commandeer) fullBuild(noBuildLock bool) error {
 		}
 	}
 
+	// Read the previous manifest before it's overwritten by this build.
+	var prevManifest *hugolib.PublishManifest
+	if c.h.compare != "" {
+		filename := c.h.compare
+		if !filepath.IsAbs(filename) {
+			filename = filepath.Join(c.Cfg.GetString("workingDir"), filename)
+		}
+		m, err := hugolib.ReadPublishManifest(c.Fs.Source, filename)
+		if err != nil {
+			return fmt.Errorf("Error reading manifest to compare with: %w", err)
+		}
+		prevManifest = &m
+	}
+
 	copyStaticFunc := func() error {
 		cnt, err := c.copyStatic()
 		if err != nil {
@@ -336,7 +358,44 @@ func (c *commandeer) fullBuild(noBuildLock bool) error {
 		s.ProcessingStats.Static = langCount[s.Language().Lang]
 	}
 
-	if c.h.gc {
+	writeManifest := c.hugo().ResourceSpec.BuildConfig.WriteManifest
+	if writeManifest || prevManifest != nil {
+		m, err := c.hugo().CreatePublishManifest()
+		if err != nil {
+			return fmt.Errorf("Error creating publish manifest: %w", err)
+		}
+		if writeManifest {
+			if err := c.hugo().WritePublishManifest(m); err != nil {
+				return fmt.Errorf("Error writing publish manifest: %w", err)
+			}
+		}
+		if prevManifest != nil {
+			diff := m.Diff(*prevManifest)
+			var report strings.Builder
+			diff.Write(&report)
+			jww.FEEDBACK.Printf("Compared build output with %s:\n%s", c.h.compare, report.String())
+			if len(diff) > 0 {
+				return fmt.Errorf("build output differs from %s in %d file(s)", c.h.compare, len(diff))
+			}
+		}
+	}
+
+	if c.h.gcDryRun {
+		unused, err := c.hugo().GCDryRun()
+		if err != nil {
+			return err
+		}
+		cacheNames := make([]string, 0, len(unused))
+		for k := range unused {
+			cacheNames = append(cacheNames, k)
+		}
+		sort.Strings(cacheNames)
+		for _, k := range cacheNames {
+			for _, name := range unused[k] {
+				jww.FEEDBACK.Printf("Would remove from cache %q: %s", k, name)
+			}
+		}
+	} else if c.h.gc {
 		count, err := c.hugo().GC()
 		if err != nil {
 			return err
@@ -518,10 +577,37 @@ func (c *commandeer) build() error {
 			}
 		}
 
+		if pathWarnings, ok := c.publishDirFs.(hugofs.PathWarningsReporter); ok {
+			if conflicts := pathWarnings.ReportCaseConflicts(); conflicts != "" {
+				c.logger.Warnln("Target paths differing only by case:", conflicts)
+			}
+			if long := pathWarnings.ReportLongPaths(); long != "" {
+				c.logger.Warnln("Target paths too long for some filesystems:", long)
+			}
+			if static := c.staticConflicts(pathWarnings.Filenames()); len(static) > 0 {
+				c.logger.Warnln("Target paths also published from static files:", strings.Join(static, ", "))
+			}
+		}
+
 		unusedTemplates := c.hugo().Tmpl().(tpl.UnusedTemplatesProvider).UnusedTemplates()
 		for _, unusedTemplate := range unusedTemplates {
 			c.logger.Warnf("Template %s is unused, source file %s", unusedTemplate.Name(), unusedTemplate.Filename())
 		}
+
+		if c.Cfg.GetBool("printImageStats") {
+			c.hugo().PrintImageStats(os.Stdout)
+			fmt.Println()
+		}
+
+		if c.Cfg.GetBool("printUnusedImages") {
+			unusedImages, err := c.hugo().UnusedImages()
+			if err != nil {
+				return err
+			}
+			for _, unusedImage := range unusedImages {
+				c.logger.Warnf("Processed image %s is unused", unusedImage)
+			}
+		}
 	}
 
 	if c.h.buildWatch {
@@ -650,10 +736,7 @@ func (c *commandeer) copyStaticTo(sourceFs *filesystems.SourceFilesystem) (uint6
 	syncer.NoChmod = c.Cfg.GetBool("noChmod")
 	syncer.ChmodFilter = chmodFilter
 	syncer.SrcFs = fs
-	syncer.DestFs = c.Fs.PublishDir
-	if c.renderStaticToDisk {
-		syncer.DestFs = c.Fs.PublishDirStatic
-	}
+	syncer.DestFs = c.staticDestFs()
 	// Now that we are using a unionFs for the static directories
 	// We can effectively clean the publishDir on initial sync
 	syncer.Delete = c.Cfg.GetBool("cleanDestinationDir")
@@ -680,6 +763,45 @@ func (c *commandeer) copyStaticTo(sourceFs *filesystems.SourceFilesystem) (uint6
 	return numFiles, err
 }
 
+// staticDestFs returns the filesystem to copy the static files to.
+func (c *commandeer) staticDestFs() afero.Fs {
+	if c.renderStaticToDisk {
+		return c.Fs.PublishDirStatic
+	}
+	if _, ok := c.Fs.PublishDir.(hugofs.DuplicatesReporter); ok {
+		// Only count the rendered files, see staticConflicts.
+		return c.Fs.PublishDir.(hugofs.FilesystemUnwrapper).UnwrapFilesystem()
+	}
+	return c.Fs.PublishDir
+}
+
+// staticConflicts returns the rendered filenames that also exist in one of
+// the static filesystems. Which one ends up in the publish dir depends on
+// timing.
+func (c *commandeer) staticConflicts(filenames []string) []string {
+	var conflicts []string
+
+	for _, filename := range filenames {
+		name := strings.TrimPrefix(filepath.Clean(filename), helpers.FilePathSeparator)
+		for _, sfs := range c.hugo().BaseFs.SourceFilesystems.Static {
+			rel := name
+			if sfs.PublishFolder != "" {
+				prefix := sfs.PublishFolder + helpers.FilePathSeparator
+				if !strings.HasPrefix(rel, prefix) {
+					continue
+				}
+				rel = strings.TrimPrefix(rel, prefix)
+			}
+			if fi, err := sfs.Fs.Stat(rel); err == nil && !fi.IsDir() {
+				conflicts = append(conflicts, filename)
+				break
+			}
+		}
+	}
+
+	return conflicts
+}
+
 func (c *commandeer) firstPathSpec() *helpers.PathSpec {
 	return c.hugo().Sites[0].PathSpec
 }
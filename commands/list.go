@@ -153,6 +153,35 @@ List requires a subcommand, e.g. ` + "`hugo list drafts`.",
 				return nil
 			},
 		},
+		&cobra.Command{
+			Use:   "scheduled",
+			Short: "List all future publish and expiry dates",
+			Long: `List the dates in the future when a page gets published or expires, sorted by date.
+
+Use the first date to schedule the next build.`,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				sites, err := cc.buildSites(map[string]any{})
+				if err != nil {
+					return newSystemError("Error building sites", err)
+				}
+
+				writer := csv.NewWriter(os.Stdout)
+				defer writer.Flush()
+
+				for _, change := range sites.ScheduledChanges() {
+					err := writer.Write([]string{
+						strings.TrimPrefix(change.Page.File().Filename(), sites.WorkingDir+string(os.PathSeparator)),
+						change.Kind,
+						change.Date.Format(time.RFC3339),
+					})
+					if err != nil {
+						return newSystemError("Error writing scheduled changes to stdout", err)
+					}
+				}
+
+				return nil
+			},
+		},
 		&cobra.Command{
 			Use:   "all",
 			Short: "List all posts",
@@ -65,6 +65,7 @@ type serverCmd struct {
 
 	disableFastRender   bool
 	disableBrowserError bool
+	logRequests         bool
 
 	*baseBuilderCmd
 }
@@ -113,6 +114,7 @@ of a second, you will be able to save and see your changes nearly instantly.`,
 	cc.cmd.Flags().BoolVar(&cc.renderStaticToDisk, "renderStaticToDisk", false, "serve static files from disk and dynamic files from memory")
 	cc.cmd.Flags().BoolVar(&cc.disableFastRender, "disableFastRender", false, "enables full re-renders on changes")
 	cc.cmd.Flags().BoolVar(&cc.disableBrowserError, "disableBrowserError", false, "do not show build errors in the browser")
+	cc.cmd.Flags().BoolVar(&cc.logRequests, "logRequests", false, "log the method, path, status and duration of every request")
 
 	cc.cmd.Flags().String("memstats", "", "log memory usage to this file")
 	cc.cmd.Flags().String("meminterval", "100ms", "interval to poll memory usage (requires --memstats), valid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".")
@@ -371,6 +373,14 @@ func (f *fileServer) createEndpoint(i int) (*http.ServeMux, net.Listener, string
 
 	decorate := func(h http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if f.s.logRequests {
+				sw := &statusResponseWriter{ResponseWriter: w, status: http.StatusOK}
+				w = sw
+				defer func(start time.Time) {
+					f.c.logger.Printf("%s %s %d %s", r.Method, r.URL.RequestURI(), sw.status, time.Since(start).Round(time.Microsecond))
+				}(time.Now())
+			}
+
 			if f.c.showErrorInBrowser {
 				// First check the error state
 				err := f.c.getErrorWithContext()
@@ -470,11 +480,52 @@ func (f *fileServer) createEndpoint(i int) (*http.ServeMux, net.Listener, string
 		mu.Handle(u.Path, http.StripPrefix(u.Path, fileserver))
 	}
 
+	mu.HandleFunc(path.Join(u.Path, explainEndpoint), func(w http.ResponseWriter, r *http.Request) {
+		targetPath := r.URL.Query().Get("path")
+		if targetPath == "" {
+			http.Error(w, "missing path, e.g. ?path=/posts/foo/", http.StatusBadRequest)
+			return
+		}
+		targetPath = strings.TrimPrefix(targetPath, strings.TrimSuffix(u.Path, "/"))
+
+		// Don't read the page graph while a rebuild is changing it.
+		h := f.c.hugo()
+		unlock, err := f.c.buildLock()
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to acquire a build lock: %s", err), http.StatusInternalServerError)
+			return
+		}
+		e := h.ExplainOutput(targetPath)
+		unlock()
+		if e == nil {
+			http.Error(w, fmt.Sprintf("no page is published to %q", targetPath), http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		e.Write(w)
+	})
+
 	endpoint := net.JoinHostPort(f.s.serverInterface, strconv.Itoa(port))
 
 	return mu, listener, u.String(), endpoint, nil
 }
 
+// explainEndpoint describes the inputs used to produce the page given in
+// the path query parameter, e.g. /__hugo/why?path=/posts/foo/.
+const explainEndpoint = "/__hugo/why"
+
+// statusResponseWriter captures the status code of a response.
+type statusResponseWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusResponseWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
 var (
 	logErrorRe                    = regexp.MustCompile(`(?s)ERROR \d{4}/\d{2}/\d{2} \d{2}:\d{2}:\d{2} `)
 	logDuplicateTemplateExecuteRe = regexp.MustCompile(`: template: .*?:\d+:\d+: executing ".*?"`)
@@ -51,10 +51,7 @@ func (s *staticSyncer) syncsStaticEvents(staticEvents []fsnotify.Event) error {
 		syncer.NoChmod = c.Cfg.GetBool("noChmod")
 		syncer.ChmodFilter = chmodFilter
 		syncer.SrcFs = sourceFs.Fs
-		syncer.DestFs = c.Fs.PublishDir
-		if c.renderStaticToDisk {
-			syncer.DestFs = c.Fs.PublishDirStatic
-		}
+		syncer.DestFs = c.staticDestFs()
 
 		// prevent spamming the log on changes
 		logger := helpers.NewDistinctErrorLogger()
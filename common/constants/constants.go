@@ -23,3 +23,13 @@ const (
 	ErrRemoteGetJSON = "error-remote-getjson"
 	ErrRemoteGetCSV  = "error-remote-getcsv"
 )
+
+// Error classes that can be set to ignore, warning or error in the
+// errorLevels section of the site config.
+const (
+	ErrClassMissingLayout      = "missingLayout"
+	ErrClassRefNotFound        = "refNotFound"
+	ErrClassMissingTranslation = "missingTranslation"
+	ErrClassRawHTMLOmitted     = "rawHTMLOmitted"
+	ErrClassUnknownShortcode   = "unknownShortcode"
+)
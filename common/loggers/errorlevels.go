@@ -0,0 +1,100 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loggers
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/spf13/cast"
+)
+
+var discardLogger = log.New(io.Discard, "", 0)
+
+// ErrorLevel is the severity of a class of errors, see ErrorLevels.
+type ErrorLevel int
+
+const (
+	// ErrorLevelIgnore silently ignores the error.
+	ErrorLevelIgnore ErrorLevel = iota + 1
+
+	// ErrorLevelWarning logs the error as a WARN.
+	ErrorLevelWarning
+
+	// ErrorLevelError logs the error as an ERROR, which fails the build.
+	ErrorLevelError
+)
+
+// ParseErrorLevel parses s, one of ignore, warning or error, into an ErrorLevel.
+func ParseErrorLevel(s string) (ErrorLevel, error) {
+	switch strings.ToLower(s) {
+	case "ignore":
+		return ErrorLevelIgnore, nil
+	case "warn", "warning":
+		return ErrorLevelWarning, nil
+	case "error", "fail":
+		return ErrorLevelError, nil
+	}
+	return 0, fmt.Errorf("invalid error level %q, must be one of ignore, warning or error", s)
+}
+
+// Logger returns the logger to log errors of level e with.
+func (e ErrorLevel) Logger(l Logger) *log.Logger {
+	switch e {
+	case ErrorLevelWarning:
+		return l.Warn()
+	case ErrorLevelError:
+		return l.Error()
+	default:
+		return discardLogger
+	}
+}
+
+// ErrorLevels maps classes of errors, e.g. "missingLayout", to their
+// configured severity.
+type ErrorLevels map[string]ErrorLevel
+
+// DecodeErrorLevels decodes the errorLevels section of the site config.
+func DecodeErrorLevels(v any) (ErrorLevels, error) {
+	levels := make(ErrorLevels)
+	if v == nil {
+		return levels, nil
+	}
+
+	m, err := maps.ToStringMapE(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode errorLevels: %w", err)
+	}
+
+	for class, s := range m {
+		level, err := ParseErrorLevel(cast.ToString(s))
+		if err != nil {
+			return nil, fmt.Errorf("errorLevels.%s: %w", class, err)
+		}
+		levels[strings.ToLower(class)] = level
+	}
+
+	return levels, nil
+}
+
+// Level returns the configured level for class, or defaultLevel if not set.
+func (e ErrorLevels) Level(class string, defaultLevel ErrorLevel) ErrorLevel {
+	if level, found := e[strings.ToLower(class)]; found {
+		return level
+	}
+	return defaultLevel
+}
@@ -0,0 +1,55 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loggers
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestDecodeErrorLevels(t *testing.T) {
+	c := qt.New(t)
+
+	levels, err := DecodeErrorLevels(map[string]any{
+		"missingLayout":    "ignore",
+		"refNotFound":      "Warning",
+		"unknownShortcode": "fail",
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(levels.Level("missinglayout", ErrorLevelWarning), qt.Equals, ErrorLevelIgnore)
+	c.Assert(levels.Level("refNotFound", ErrorLevelError), qt.Equals, ErrorLevelWarning)
+	c.Assert(levels.Level("unknownShortcode", ErrorLevelWarning), qt.Equals, ErrorLevelError)
+	c.Assert(levels.Level("rawHTMLOmitted", ErrorLevelIgnore), qt.Equals, ErrorLevelIgnore)
+
+	levels, err = DecodeErrorLevels(nil)
+	c.Assert(err, qt.IsNil)
+	c.Assert(levels.Level("missingLayout", ErrorLevelWarning), qt.Equals, ErrorLevelWarning)
+
+	_, err = DecodeErrorLevels(map[string]any{"missingLayout": "panic"})
+	c.Assert(err, qt.ErrorMatches, `errorLevels.missingLayout: invalid error level "panic".*`)
+}
+
+func TestErrorLevelLogger(t *testing.T) {
+	c := qt.New(t)
+	l := NewWarningLogger()
+
+	ErrorLevelIgnore.Logger(l).Println("ignored")
+	ErrorLevelWarning.Logger(l).Println("warning")
+	ErrorLevelError.Logger(l).Println("error")
+
+	// The warn counter counts warnings and errors.
+	c.Assert(l.LogCounters().WarnCounter.Count(), qt.Equals, uint64(2))
+	c.Assert(l.LogCounters().ErrorCounter.Count(), qt.Equals, uint64(1))
+}
@@ -15,14 +15,17 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gohugoio/hugo/common/types"
 
 	"github.com/gobwas/glob"
 	"github.com/gohugoio/hugo/common/herrors"
+	hglob "github.com/gohugoio/hugo/hugofs/glob"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/cast"
 	jww "github.com/spf13/jwalterweatherman"
@@ -44,6 +47,14 @@ type Build struct {
 	// Can be used to toggle off writing of the intellinsense /assets/jsconfig.js
 	// file.
 	NoJSConfigInAssets bool
+
+	// When enabled, will write a hugo_manifest.json with the size and MD5 hash
+	// of every file in the publish directory.
+	WriteManifest bool
+
+	// When enabled, will write a hugo_scheduled.json with the future publish
+	// and expiry dates of the pages, see HugoSites.ScheduledChanges.
+	WriteScheduled bool
 }
 
 func (b Build) UseResourceCache(err error) bool {
@@ -79,11 +90,50 @@ func DecodeBuild(cfg Provider) Build {
 	return b
 }
 
+var DefaultContentFiles = ContentFiles{
+	WarnSize: 50 << 20, // 50 MB
+}
+
+// ContentFiles configures how the files found in the content directories are
+// collected.
+type ContentFiles struct {
+	// Files larger than this (in bytes) will be reported with a warning, as they
+	// are most likely committed by accident. Set to 0 to disable.
+	WarnSize int64
+
+	// Glob patterns matching the non-content files (e.g. "**.{jpg,png}") to
+	// collect as page resources. If not set, all non-content files are included.
+	Includes []string
+
+	// When enabled, non-content files in sections (branch bundles) are
+	// published verbatim to the same relative path below publishDir, as if they
+	// were static files, instead of becoming page resources of the section.
+	// Files in leaf bundles are not affected.
+	Static bool
+}
+
+func DecodeContentFiles(cfg Provider) (ContentFiles, error) {
+	c := DefaultContentFiles
+	m := cfg.GetStringMap("contentFiles")
+	if m == nil {
+		return c, nil
+	}
+
+	if err := mapstructure.WeakDecode(m, &c); err != nil {
+		return c, fmt.Errorf("failed to decode contentFiles config: %w", err)
+	}
+
+	return c, nil
+}
+
 // Sitemap configures the sitemap to be generated.
 type Sitemap struct {
 	ChangeFreq string
 	Priority   float64
 	Filename   string
+
+	// Set to true to exclude the page(s) from the sitemap.
+	Disable bool
 }
 
 func DecodeSitemap(prototype Sitemap, input map[string]any) Sitemap {
@@ -95,6 +145,8 @@ func DecodeSitemap(prototype Sitemap, input map[string]any) Sitemap {
 			prototype.Priority = cast.ToFloat64(value)
 		case "filename":
 			prototype.Filename = cast.ToString(value)
+		case "disable":
+			prototype.Disable = cast.ToBool(value)
 		default:
 			jww.WARN.Printf("Unknown Sitemap field: %s\n", key)
 		}
@@ -103,6 +155,205 @@ func DecodeSitemap(prototype Sitemap, input map[string]any) Sitemap {
 	return prototype
 }
 
+// HTTPCache configures the caching of remote resources, e.g. from getJSON
+// and resources.GetRemote.
+type HTTPCache struct {
+	// Overrides the max age of the file cache for matching URLs.
+	TTL []HTTPCacheTTL
+
+	// How to retry failed requests.
+	Retry HTTPRetry
+
+	// Status codes outside of the 2xx range that should not be treated
+	// as errors, e.g. 410.
+	AllowStatusCodes []int
+
+	compiledTTL []glob.Glob
+}
+
+// IsAllowedStatusCode reports whether a response with the given status code
+// should be accepted.
+func (c HTTPCache) IsAllowedStatusCode(code int) bool {
+	if code >= 200 && code <= 299 {
+		return true
+	}
+	for _, allowed := range c.AllowStatusCodes {
+		if code == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+var DefaultHTTPRetry = HTTPRetry{
+	Attempts:       0,
+	InitialBackoff: time.Second,
+	MaxBackoff:     10 * time.Second,
+	StatusCodes:    []int{429, 500, 502, 503, 504},
+}
+
+// HTTPRetry configures the retry of failed requests for remote resources.
+// Network errors and responses with one of StatusCodes are retried.
+type HTTPRetry struct {
+	// The number of retries after the first attempt. Default is 0.
+	Attempts int
+
+	// The time to wait before the first retry. It's doubled for every
+	// retry up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	// The status codes to retry.
+	StatusCodes []int
+}
+
+// ShouldRetry reports whether the given failed attempt, starting at 0,
+// should be retried.
+func (r HTTPRetry) ShouldRetry(attempt, statusCode int, err error) bool {
+	if attempt >= r.Attempts {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	for _, code := range r.StatusCodes {
+		if statusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff returns the time to wait before retrying the given attempt.
+func (r HTTPRetry) Backoff(attempt int) time.Duration {
+	d := r.InitialBackoff
+	for i := 0; i < attempt && d < r.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > r.MaxBackoff {
+		d = r.MaxBackoff
+	}
+	return d
+}
+
+// HTTPCacheTTL sets the max age for the cached remote resources with a URL
+// matching the glob pattern in For. A negative value means forever.
+type HTTPCacheTTL struct {
+	For    string
+	MaxAge time.Duration
+}
+
+// MaxAge returns the max age of the first TTL rule matching url, if found.
+func (c HTTPCache) MaxAge(url string) (time.Duration, bool) {
+	for i, g := range c.compiledTTL {
+		if g.Match(url) {
+			return c.TTL[i].MaxAge, true
+		}
+	}
+	return 0, false
+}
+
+func DecodeHTTPCache(cfg Provider) (HTTPCache, error) {
+	c := HTTPCache{Retry: DefaultHTTPRetry}
+	m := cfg.GetStringMap("httpCache")
+	if m == nil {
+		return c, nil
+	}
+
+	// Decoding into a non-empty slice would merge the values.
+	c.Retry.StatusCodes = nil
+
+	dc := &mapstructure.DecoderConfig{
+		Result:           &c,
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+		WeaklyTypedInput: true,
+	}
+
+	decoder, err := mapstructure.NewDecoder(dc)
+	if err != nil {
+		return c, err
+	}
+
+	if err := decoder.Decode(m); err != nil {
+		return c, fmt.Errorf("failed to decode httpCache config: %w", err)
+	}
+
+	if c.Retry.StatusCodes == nil {
+		c.Retry.StatusCodes = DefaultHTTPRetry.StatusCodes
+	}
+
+	for _, ttl := range c.TTL {
+		g, err := glob.Compile(ttl.For)
+		if err != nil {
+			return c, fmt.Errorf("failed to compile httpCache TTL pattern %q: %w", ttl.For, err)
+		}
+		c.compiledTTL = append(c.compiledTTL, g)
+	}
+
+	return c, nil
+}
+
+// CDN configures a base URL for the published resources (e.g. processed
+// images and fingerprinted assets) that is different from the site's baseURL,
+// e.g. a cookieless domain.
+type CDN struct {
+	// The base URL, e.g. https://cdn.example.org/. The resource paths are
+	// appended to this as is, so the CDN should mirror the publish directory.
+	BaseURL string
+
+	// Glob patterns matching the paths of the resources that should keep
+	// using the site's baseURL, e.g. "/sw.js" or "**.json".
+	ExcludeFiles []string
+
+	excludes []glob.Glob
+}
+
+// URL returns the URL on the CDN for the resource with the given path relative
+// to the site's root, and false if the CDN is not enabled or the resource is excluded.
+func (c CDN) URL(rel string) (string, bool) {
+	if c.BaseURL == "" {
+		return "", false
+	}
+	for _, g := range c.excludes {
+		if g.Match(hglob.NormalizePath(rel)) {
+			return "", false
+		}
+	}
+	return c.BaseURL + "/" + strings.TrimPrefix(rel, "/"), true
+}
+
+func DecodeCDN(cfg Provider) (CDN, error) {
+	var c CDN
+	m := cfg.GetStringMap("cdn")
+	if m == nil {
+		return c, nil
+	}
+
+	if err := mapstructure.WeakDecode(m, &c); err != nil {
+		return c, fmt.Errorf("failed to decode cdn config: %w", err)
+	}
+
+	if c.BaseURL == "" {
+		return c, nil
+	}
+
+	u, err := url.Parse(c.BaseURL)
+	if err != nil || u.Host == "" {
+		return c, fmt.Errorf("cdn baseURL %q must be an absolute URL, e.g. \"https://cdn.example.org/\"", c.BaseURL)
+	}
+	c.BaseURL = strings.TrimSuffix(c.BaseURL, "/")
+
+	for _, pattern := range c.ExcludeFiles {
+		g, err := hglob.GetGlob(hglob.NormalizePath(pattern))
+		if err != nil {
+			return c, fmt.Errorf("failed to compile cdn exclude pattern %q: %w", pattern, err)
+		}
+		c.excludes = append(c.excludes, g)
+	}
+
+	return c, nil
+}
+
 // Config for the dev server.
 type Server struct {
 	Headers   []Headers
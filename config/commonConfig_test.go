@@ -16,6 +16,7 @@ package config
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/gohugoio/hugo/common/herrors"
 	"github.com/gohugoio/hugo/common/types"
@@ -138,3 +139,109 @@ status = 301`,
 
 	}
 }
+
+func TestHTTPCache(t *testing.T) {
+	c := qt.New(t)
+
+	cfg, err := FromConfigString(`[[httpCache.ttl]]
+for = "https://api.example.org/**"
+maxAge = "1h"
+
+[[httpCache.ttl]]
+for = "https://static.example.org/**"
+maxAge = -1
+`, "toml")
+	c.Assert(err, qt.IsNil)
+
+	hc, err := DecodeHTTPCache(cfg)
+	c.Assert(err, qt.IsNil)
+
+	maxAge, found := hc.MaxAge("https://api.example.org/v1/data.json")
+	c.Assert(found, qt.IsTrue)
+	c.Assert(maxAge, qt.Equals, time.Hour)
+	maxAge, found = hc.MaxAge("https://static.example.org/logo.png")
+	c.Assert(found, qt.IsTrue)
+	c.Assert(maxAge, qt.Equals, time.Duration(-1))
+	_, found = hc.MaxAge("https://example.org/data.json")
+	c.Assert(found, qt.IsFalse)
+
+	cfg, err = FromConfigString(`[[httpCache.ttl]]
+for = "https://api.example.org/[**"
+maxAge = "1h"
+`, "toml")
+	c.Assert(err, qt.IsNil)
+	_, err = DecodeHTTPCache(cfg)
+	c.Assert(err, qt.ErrorMatches, `failed to compile httpCache TTL pattern.*`)
+}
+
+func TestHTTPRetry(t *testing.T) {
+	c := qt.New(t)
+
+	hc, err := DecodeHTTPCache(New())
+	c.Assert(err, qt.IsNil)
+	c.Assert(hc.Retry, qt.DeepEquals, DefaultHTTPRetry)
+	c.Assert(hc.Retry.ShouldRetry(0, 503, nil), qt.IsFalse)
+	c.Assert(hc.IsAllowedStatusCode(204), qt.IsTrue)
+	c.Assert(hc.IsAllowedStatusCode(410), qt.IsFalse)
+
+	cfg, err := FromConfigString(`[httpCache]
+allowStatusCodes = [410]
+[httpCache.retry]
+attempts = 3
+initialBackoff = "100ms"
+maxBackoff = "300ms"
+statusCodes = [503]
+`, "toml")
+	c.Assert(err, qt.IsNil)
+
+	hc, err = DecodeHTTPCache(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(hc.IsAllowedStatusCode(410), qt.IsTrue)
+
+	r := hc.Retry
+	c.Assert(r.StatusCodes, qt.DeepEquals, []int{503})
+	c.Assert(r.ShouldRetry(0, 503, nil), qt.IsTrue)
+	c.Assert(r.ShouldRetry(2, 503, nil), qt.IsTrue)
+	c.Assert(r.ShouldRetry(3, 503, nil), qt.IsFalse)
+	c.Assert(r.ShouldRetry(0, 500, nil), qt.IsFalse)
+	c.Assert(r.ShouldRetry(0, 0, errors.New("timeout")), qt.IsTrue)
+	c.Assert(r.Backoff(0), qt.Equals, 100*time.Millisecond)
+	c.Assert(r.Backoff(1), qt.Equals, 200*time.Millisecond)
+	c.Assert(r.Backoff(2), qt.Equals, 300*time.Millisecond)
+	c.Assert(r.Backoff(10), qt.Equals, 300*time.Millisecond)
+}
+
+func TestCDN(t *testing.T) {
+	c := qt.New(t)
+
+	cdn, err := DecodeCDN(New())
+	c.Assert(err, qt.IsNil)
+	_, found := cdn.URL("/images/a.png")
+	c.Assert(found, qt.IsFalse)
+
+	cfg, err := FromConfigString(`[cdn]
+baseURL = "https://cdn.example.org/"
+excludeFiles = ["/sw.js", "**.JSON"]
+`, "toml")
+	c.Assert(err, qt.IsNil)
+
+	cdn, err = DecodeCDN(cfg)
+	c.Assert(err, qt.IsNil)
+
+	u, found := cdn.URL("/images/a.png")
+	c.Assert(found, qt.IsTrue)
+	c.Assert(u, qt.Equals, "https://cdn.example.org/images/a.png")
+	_, found = cdn.URL("/sw.js")
+	c.Assert(found, qt.IsFalse)
+	_, found = cdn.URL("/data/d.json")
+	c.Assert(found, qt.IsFalse)
+	_, found = cdn.URL("/js/sw.js")
+	c.Assert(found, qt.IsTrue)
+
+	cfg, err = FromConfigString(`[cdn]
+baseURL = "cdn.example.org"
+`, "toml")
+	c.Assert(err, qt.IsNil)
+	_, err = DecodeCDN(cfg)
+	c.Assert(err, qt.ErrorMatches, `cdn baseURL "cdn.example.org" must be an absolute URL.*`)
+}
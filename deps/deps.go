@@ -37,6 +37,10 @@ type Deps struct {
 	// Used to log errors that may repeat itself many times.
 	LogDistinct loggers.Logger
 
+	// The configured severity of the error classes in
+	// common/constants, e.g. missing layouts.
+	ErrorLevels loggers.ErrorLevels
+
 	ExecHelper *hexec.Exec
 
 	// The templates to use. This will usually implement the full tpl.TemplateManager.
@@ -256,6 +260,10 @@ func New(cfg DepsCfg) (*Deps, error) {
 	if err != nil {
 		return nil, err
 	}
+	if cfg.Running {
+		// The CDN only has what we have published.
+		resourceSpec.CDN = config.CDN{}
+	}
 
 	contentSpec, err := helpers.NewContentSpec(cfg.Language, logger, ps.BaseFs.Content.Fs, execHelper)
 	if err != nil {
@@ -272,12 +280,18 @@ func New(cfg DepsCfg) (*Deps, error) {
 	ignoreErrors := cast.ToStringSlice(cfg.Cfg.Get("ignoreErrors"))
 	ignorableLogger := loggers.NewIgnorableLogger(logger, ignoreErrors...)
 
+	errorLevels, err := loggers.DecodeErrorLevels(cfg.Cfg.Get("errorLevels"))
+	if err != nil {
+		return nil, err
+	}
+
 	logDistinct := helpers.NewDistinctLogger(logger)
 
 	d := &Deps{
 		Fs:                      fs,
 		Log:                     ignorableLogger,
 		LogDistinct:             logDistinct,
+		ErrorLevels:             errorLevels,
 		ExecHelper:              execHelper,
 		templateProvider:        cfg.TemplateProvider,
 		translationProvider:     cfg.TranslationProvider,
@@ -332,12 +346,17 @@ func (d Deps) ForLanguage(cfg DepsCfg, onCreated func(d *Deps) error) (*Deps, er
 	// TODO(bep) clean up these inits.
 	resourceCache := d.ResourceSpec.ResourceCache
 	postBuildAssets := d.ResourceSpec.PostBuildAssets
+	imageProcSem := d.ResourceSpec.ImageProcSem
 	d.ResourceSpec, err = resources.NewSpec(d.PathSpec, d.ResourceSpec.FileCaches, d.BuildState, d.Log, d.globalErrHandler, d.ExecHelper, cfg.OutputFormats, cfg.MediaTypes)
 	if err != nil {
 		return nil, err
 	}
+	if d.Running {
+		d.ResourceSpec.CDN = config.CDN{}
+	}
 	d.ResourceSpec.ResourceCache = resourceCache
 	d.ResourceSpec.PostBuildAssets = postBuildAssets
+	d.ResourceSpec.ImageProcSem = imageProcSem
 
 	d.Cfg = l
 	d.Language = l
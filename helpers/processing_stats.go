@@ -14,6 +14,7 @@
 package helpers
 
 import (
+	"fmt"
 	"io"
 	"strconv"
 	"sync/atomic"
@@ -27,12 +28,18 @@ type ProcessingStats struct {
 
 	Pages           uint64
 	PaginatorPages  uint64
+	TaxonomyTerms   uint64
 	Static          uint64
 	ProcessedImages uint64
 	Files           uint64
 	Aliases         uint64
 	Sitemaps        uint64
 	Cleaned         uint64
+
+	// Image processing budget, see ImageStatsTable.
+	ImageCacheHits    uint64
+	ImageBytesRead    uint64
+	ImageBytesWritten uint64
 }
 
 type processingStatsTitleVal struct {
@@ -44,6 +51,7 @@ func (s *ProcessingStats) toVals() []processingStatsTitleVal {
 	return []processingStatsTitleVal{
 		{"Pages", s.Pages},
 		{"Paginator pages", s.PaginatorPages},
+		{"Taxonomy terms", s.TaxonomyTerms},
 		{"Non-page files", s.Files},
 		{"Static files", s.Static},
 		{"Processed images", s.ProcessedImages},
@@ -118,3 +126,40 @@ func ProcessingStatsTable(w io.Writer, stats ...*ProcessingStats) {
 	table.SetBorder(false)
 	table.Render()
 }
+
+// ImageStatsTable writes a table-formatted representation of the image
+// processing in stats to w.
+func ImageStatsTable(w io.Writer, stats ...*ProcessingStats) {
+	names := make([]string, len(stats)+1)
+	data := [][]string{
+		{"Processed images"},
+		{"Cache hits"},
+		{"Cache hit rate"},
+		{"Bytes read"},
+		{"Bytes written"},
+	}
+
+	for i, stat := range stats {
+		names[i+1] = stat.Name
+
+		processed := atomic.LoadUint64(&stat.ProcessedImages)
+		hits := atomic.LoadUint64(&stat.ImageCacheHits)
+		hitRate := "-"
+		if processed > 0 {
+			hitRate = fmt.Sprintf("%.0f%%", float64(hits)/float64(processed)*100)
+		}
+
+		data[0] = append(data[0], strconv.FormatUint(processed, 10))
+		data[1] = append(data[1], strconv.FormatUint(hits, 10))
+		data[2] = append(data[2], hitRate)
+		data[3] = append(data[3], strconv.FormatUint(atomic.LoadUint64(&stat.ImageBytesRead), 10))
+		data[4] = append(data[4], strconv.FormatUint(atomic.LoadUint64(&stat.ImageBytesWritten), 10))
+	}
+
+	table := tablewriter.NewWriter(w)
+
+	table.AppendBulk(data)
+	table.SetHeader(names)
+	table.SetBorder(false)
+	table.Render()
+}
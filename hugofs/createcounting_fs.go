@@ -16,9 +16,11 @@ package hugofs
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"sync"
+	"unicode/utf8"
 
 	"github.com/spf13/afero"
 )
@@ -33,8 +35,34 @@ type DuplicatesReporter interface {
 	ReportDuplicates() string
 }
 
+// PathWarningsReporter reports about filenames that may cause problems
+// when the published site is checked out or served on other systems.
+type PathWarningsReporter interface {
+	// ReportCaseConflicts reports filenames differing only by case. These
+	// will overwrite each other on case insensitive filesystems, the
+	// default on macOS and Windows.
+	ReportCaseConflicts() string
+
+	// ReportLongPaths reports filenames exceeding common filesystem limits.
+	ReportLongPaths() string
+
+	// Filenames returns the filenames written, sorted.
+	Filenames() []string
+}
+
+const (
+	// Most filesystems limit a file or directory name to 255 bytes.
+	maxPathElementLen = 255
+
+	// MAX_PATH on Windows. Note that the filenames are relative to
+	// the publish dir, so this is a lower bound.
+	maxPathLen = 260
+)
+
 var (
-	_ FilesystemUnwrapper = (*createCountingFs)(nil)
+	_ FilesystemUnwrapper  = (*createCountingFs)(nil)
+	_ DuplicatesReporter   = (*createCountingFs)(nil)
+	_ PathWarningsReporter = (*createCountingFs)(nil)
 )
 
 func NewCreateCountingFs(fs afero.Fs) afero.Fs {
@@ -67,6 +95,83 @@ func (c *createCountingFs) ReportDuplicates() string {
 	return strings.Join(dupes, ", ")
 }
 
+// ReportCaseConflicts reports filenames that differ only by case.
+func (c *createCountingFs) ReportCaseConflicts() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	groups := make(map[string][]string)
+	for k := range c.fileCount {
+		key := strings.ToLower(filepath.Clean(k))
+		groups[key] = append(groups[key], k)
+	}
+
+	var conflicts []string
+
+	for _, v := range groups {
+		if len(v) > 1 {
+			sort.Strings(v)
+			conflicts = append(conflicts, strings.Join(v, " and "))
+		}
+	}
+
+	if len(conflicts) == 0 {
+		return ""
+	}
+
+	sort.Strings(conflicts)
+
+	return strings.Join(conflicts, ", ")
+}
+
+// ReportLongPaths reports filenames with a path element longer than 255 bytes
+// or a total length above 260 characters.
+func (c *createCountingFs) ReportLongPaths() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var long []string
+
+	for k := range c.fileCount {
+		var warning string
+		for _, part := range strings.Split(filepath.ToSlash(k), "/") {
+			if len(part) > maxPathElementLen {
+				warning = fmt.Sprintf("%s (path element of %d bytes)", k, len(part))
+				break
+			}
+		}
+		if n := utf8.RuneCountInString(k); warning == "" && n > maxPathLen {
+			warning = fmt.Sprintf("%s (%d characters)", k, n)
+		}
+		if warning != "" {
+			long = append(long, warning)
+		}
+	}
+
+	if len(long) == 0 {
+		return ""
+	}
+
+	sort.Strings(long)
+
+	return strings.Join(long, ", ")
+}
+
+// Filenames returns the sorted filenames written.
+func (c *createCountingFs) Filenames() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	filenames := make([]string, 0, len(c.fileCount))
+	for k := range c.fileCount {
+		filenames = append(filenames, k)
+	}
+
+	sort.Strings(filenames)
+
+	return filenames
+}
+
 // createCountingFs counts filenames of created files or files opened
 // for writing.
 type createCountingFs struct {
@@ -0,0 +1,64 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugofs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/spf13/afero"
+)
+
+func TestCreateCountingFs(t *testing.T) {
+	c := qt.New(t)
+
+	fs := NewCreateCountingFs(afero.NewMemMapFs())
+
+	longElement := strings.Repeat("a", 256)
+	longPath := strings.Repeat(filepath.FromSlash("abcdefghi/"), 26) + "index.html"
+
+	for _, name := range []string{
+		filepath.FromSlash("posts/foo/index.html"),
+		filepath.FromSlash("posts/Foo/index.html"),
+		filepath.FromSlash("posts/bar/index.html"),
+		filepath.FromSlash("posts/bar/index.html"),
+		filepath.Join("posts", longElement, "index.html"),
+		longPath,
+	} {
+		f, err := fs.Create(name)
+		c.Assert(err, qt.IsNil)
+		c.Assert(f.Close(), qt.IsNil)
+	}
+
+	// Opened for reading only.
+	f, err := fs.OpenFile(filepath.FromSlash("posts/BAR/index.html"), os.O_RDONLY, 0)
+	c.Assert(err, qt.Not(qt.IsNil))
+	c.Assert(f, qt.IsNil)
+
+	d := fs.(DuplicatesReporter)
+	c.Assert(d.ReportDuplicates(), qt.Equals, filepath.FromSlash("posts/bar/index.html (2)"))
+
+	w := fs.(PathWarningsReporter)
+	c.Assert(w.ReportCaseConflicts(), qt.Equals, filepath.FromSlash("posts/Foo/index.html and posts/foo/index.html"))
+	c.Assert(w.ReportLongPaths(), qt.Equals, longPath+" (270 characters), "+filepath.Join("posts", longElement, "index.html")+" (path element of 256 bytes)")
+	c.Assert(w.Filenames(), qt.HasLen, 5)
+
+	fs.(Reseter).Reset()
+	c.Assert(d.ReportDuplicates(), qt.Equals, "")
+	c.Assert(w.ReportCaseConflicts(), qt.Equals, "")
+	c.Assert(w.ReportLongPaths(), qt.Equals, "")
+}
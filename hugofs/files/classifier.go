@@ -15,6 +15,7 @@ package files
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
 	"io"
 	"os"
@@ -137,6 +138,14 @@ func ClassifyContentFile(filename string, open func() (afero.File, error)) Conte
 	return ContentClassContent
 }
 
+// IsBinaryContent reports whether the content in r looks like binary data,
+// i.e. it has a NUL byte within its first 512 bytes.
+func IsBinaryContent(r io.Reader) bool {
+	b := make([]byte, 512)
+	n, _ := io.ReadFull(r, b)
+	return bytes.IndexByte(b[:n], 0) != -1
+}
+
 var htmlComment = []rune{'<', '!', '-', '-'}
 
 func isHTMLContent(r io.Reader) bool {
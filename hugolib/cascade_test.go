@@ -628,3 +628,71 @@ S1|p1:|p2:p2|
 		`)
 	})
 }
+
+func TestContentDirDefaults(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+-- content/_index.md --
+---
+title: Home
+cascade:
+  color: cascade
+  size: cascade
+---
+-- content/posts/_meta.toml --
+author = "Jo"
+color = "meta"
+shape = "round"
+-- content/posts/p1.md --
+---
+title: P1
+---
+-- content/posts/p2.md --
+---
+title: P2
+author: "Kim"
+---
+-- content/posts/bundle/index.md --
+---
+title: Bundle
+---
+-- content/posts/bundle/_meta.toml --
+author = "Bundle resource"
+-- content/posts/sub/p3.md --
+---
+title: P3
+---
+-- layouts/index.html --
+Home.
+-- layouts/_default/list.html --
+List: {{ .Title }}|{{ .Params.author }}|{{ .Params.color }}|
+-- layouts/_default/single.html --
+Single: {{ .Title }}|{{ .Params.author }}|{{ .Params.color }}|{{ .Params.size }}|{{ .Params.shape }}|{{ range .Resources }}Resource: {{ .Name }}|{{ end }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			Running:     true,
+		},
+	).Build()
+
+	b.AssertFileContent("public/posts/p1/index.html", "Single: P1|Jo|meta|cascade|round|")
+	b.AssertFileContent("public/posts/p2/index.html", "Single: P2|Kim|meta|cascade|round|")
+	b.AssertFileContent("public/posts/bundle/index.html", "Single: Bundle|Jo|meta|cascade|round|Resource: _meta.toml|")
+	b.AssertFileContent("public/posts/sub/p3/index.html", "Single: P3||cascade|cascade||")
+	b.AssertFileContent("public/posts/index.html", "List: Posts||cascade|")
+	b.AssertDestinationExists("posts/_meta.toml", false)
+	b.AssertDestinationExists("posts/bundle/_meta.toml", true)
+
+	b.EditFileReplace("content/posts/_meta.toml", func(s string) string {
+		return strings.Replace(s, `author = "Jo"`, `author = "Sam"`, 1)
+	}).Build()
+
+	b.AssertFileContent("public/posts/p1/index.html", "Single: P1|Sam|meta|cascade|round|")
+	b.AssertFileContent("public/posts/p2/index.html", "Single: P2|Kim|meta|cascade|round|")
+}
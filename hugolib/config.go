@@ -14,6 +14,7 @@
 package hugolib
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -155,6 +156,10 @@ func LoadConfig(d ConfigSourceDescriptor, doWithConfig ...func(cfg config.Provid
 		return l.cfg, configFiles, err
 	}
 
+	if err = l.applyURLMode(); err != nil {
+		return l.cfg, configFiles, err
+	}
+
 	if err == nil {
 		err = modulesCollectErr
 	}
@@ -217,6 +222,18 @@ type SiteConfig struct {
 	Services services.Config
 }
 
+// The values of the urlMode setting.
+const (
+	// Rewrite root relative URLs in HTML and XML to absolute, as canonifyURLs.
+	urlModeAbsolute = "absolute"
+
+	// Rewrite root relative URLs in HTML to relative to the page, as relativeURLs.
+	urlModeRelative = "relative"
+
+	// Rewrite no URLs, but inject a canonical link in the head of HTML pages.
+	urlModeCanonical = "canonical"
+)
+
 type configLoader struct {
 	cfg config.Provider
 	ConfigSourceDescriptor
@@ -236,6 +253,29 @@ func (l configLoader) applyConfigAliases() error {
 	return nil
 }
 
+// applyURLMode sets canonifyURLs and relativeURLs from urlMode, if set.
+func (l configLoader) applyURLMode() error {
+	if !l.cfg.IsSet("urlMode") {
+		return nil
+	}
+	mode := strings.ToLower(l.cfg.GetString("urlMode"))
+	switch mode {
+	case urlModeAbsolute:
+		l.cfg.Set("canonifyURLs", true)
+		l.cfg.Set("relativeURLs", false)
+	case urlModeRelative:
+		l.cfg.Set("canonifyURLs", false)
+		l.cfg.Set("relativeURLs", true)
+	case urlModeCanonical:
+		l.cfg.Set("canonifyURLs", false)
+		l.cfg.Set("relativeURLs", false)
+	default:
+		return fmt.Errorf("invalid urlMode %q, must be one of %q, %q or %q", mode, urlModeAbsolute, urlModeRelative, urlModeCanonical)
+	}
+	l.cfg.Set("urlMode", mode)
+	return nil
+}
+
 func (l configLoader) applyConfigDefaults() error {
 	defaultSettings := maps.Params{
 		"cleanDestinationDir":                  false,
@@ -250,6 +290,7 @@ func (l configLoader) applyConfigDefaults() error {
 		"uglyURLs":                             false,
 		"verbose":                              false,
 		"ignoreCache":                          false,
+		"offline":                              false,
 		"canonifyURLs":                         false,
 		"relativeURLs":                         false,
 		"removePathAccents":                    false,
@@ -268,6 +309,7 @@ func (l configLoader) applyConfigDefaults() error {
 		"summaryLength":                        70,
 		"rssLimit":                             -1,
 		"sectionPagesMenu":                     "",
+		"sectionTreeHiddenParam":               "hidden",
 		"disablePathToLower":                   false,
 		"hasCJKLanguage":                       false,
 		"enableEmoji":                          false,
@@ -366,6 +408,12 @@ func (l configLoader) collectModules(modConfig modules.Config, v1 config.Provide
 		return nil, nil, err
 	}
 
+	httpCacheConfig, err := config.DecodeHTTPCache(v1)
+	if err != nil {
+		return nil, nil, err
+	}
+	v1.Set("httpCacheConfig", httpCacheConfig)
+
 	secConfig, err := security.DecodeConfig(v1)
 	if err != nil {
 		return nil, nil, err
@@ -74,6 +74,19 @@ vigneron = "vignerons"`, fromDir)
 			})
 		})
 	}
+	c.Run("URL mode", func(c *qt.C) {
+		c.Parallel()
+		cfg := loadConfig(c, `urlMode = "Relative"
+canonifyURLs = true`, false)
+		c.Assert(cfg.GetString("urlMode"), qt.Equals, "relative")
+		c.Assert(cfg.GetBool("canonifyURLs"), qt.IsFalse)
+		c.Assert(cfg.GetBool("relativeURLs"), qt.IsTrue)
+
+		mm := afero.NewMemMapFs()
+		writeToFs(t, mm, "config.toml", `urlMode = "foo"`)
+		_, _, err := LoadConfig(ConfigSourceDescriptor{Fs: mm})
+		c.Assert(err, qt.ErrorMatches, `invalid urlMode "foo".*`)
+	})
 }
 
 func TestLoadMultiConfig(t *testing.T) {
@@ -0,0 +1,138 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/hugofs/files"
+	"github.com/gohugoio/hugo/parser/metadecoders"
+	"github.com/gohugoio/hugo/resources/page"
+	"github.com/spf13/afero"
+)
+
+// The base name of the files in a content directory with front matter
+// defaults for the regular pages in it, e.g. _meta.toml.
+const contentDirDefaultsBaseName = "_meta"
+
+var contentDirDefaultsFormats = []string{"toml", "yaml", "yml", "json"}
+
+// isContentDirDefaultsFile reports whether filename is a front matter
+// defaults file, e.g. "posts/_meta.toml".
+func isContentDirDefaultsFile(filename string) bool {
+	base := filepath.Base(filename)
+	ext := filepath.Ext(base)
+	if strings.TrimSuffix(base, ext) != contentDirDefaultsBaseName {
+		return false
+	}
+	ext = strings.TrimPrefix(ext, ".")
+	for _, f := range contentDirDefaultsFormats {
+		if ext == f {
+			return true
+		}
+	}
+	return false
+}
+
+// contentDirDefaults holds the parsed _meta files by directory.
+type contentDirDefaults struct {
+	mu       sync.Mutex
+	defaults map[string]maps.Params
+}
+
+// get returns the front matter defaults for the regular page p, nil if none.
+func (c *contentDirDefaults) get(p *pageState) (maps.Params, error) {
+	if p.File().IsZero() {
+		return nil, nil
+	}
+	meta := p.File().FileInfo().Meta()
+	dir := filepath.Dir(meta.Filename)
+	switch meta.Classifier {
+	case files.ContentClassBranch:
+		return nil, nil
+	case files.ContentClassLeaf:
+		// The bundle is the page, use the directory it lives in.
+		dir = filepath.Dir(dir)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.defaults == nil {
+		c.defaults = make(map[string]maps.Params)
+	}
+
+	if defaults, found := c.defaults[dir]; found {
+		return defaults, nil
+	}
+
+	defaults, err := c.load(p.s.Fs.Source, dir)
+	if err != nil {
+		return nil, err
+	}
+	c.defaults[dir] = defaults
+
+	return defaults, nil
+}
+
+func (c *contentDirDefaults) load(fs afero.Fs, dir string) (maps.Params, error) {
+	for _, ext := range contentDirDefaultsFormats {
+		filename := filepath.Join(dir, contentDirDefaultsBaseName+"."+ext)
+		b, err := afero.ReadFile(fs, filename)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		m, err := metadecoders.Default.UnmarshalToMap(b, metadecoders.FormatFromString(ext))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %q: %w", filename, err)
+		}
+		maps.PrepareParams(m)
+		return m, nil
+	}
+	return nil, nil
+}
+
+// reset forgets the defaults loaded from filename, to be called when it
+// changes.
+func (c *contentDirDefaults) reset(filename string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.defaults, filepath.Dir(filename))
+}
+
+// applyContentDirDefaults sets the keys in the defaults for the page's
+// directory not set in frontmatter.
+func applyContentDirDefaults(p *pageState, frontmatter map[string]any) error {
+	if p.Kind() != page.KindPage {
+		return nil
+	}
+	defaults, err := p.s.h.contentDirDefaults.get(p)
+	if err != nil {
+		return err
+	}
+	for k, v := range defaults {
+		if _, found := frontmatter[k]; !found {
+			frontmatter[k] = v
+		}
+	}
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gohugoio/hugo/helpers"
 
@@ -386,6 +387,9 @@ func (m *contentMap) AddFilesBundle(header hugofs.FileMetaInfo, resources ...hug
 	}
 
 	for _, r := range resources {
+		if mt := r.ModTime(); mt.After(n.resourcesModTime) {
+			n.resourcesModTime = mt
+		}
 		rb := b.ForResource(cleanTreeKey(r.Meta().Path))
 		rb.Insert(&contentNode{fi: r})
 	}
@@ -767,6 +771,9 @@ type contentNode struct {
 
 	// The source path. Unix slashes. No leading slash.
 	path string
+
+	// The newest OS mod time of the bundle's resources, if any.
+	resourcesModTime time.Time
 }
 
 func (b *contentNode) rootSection() string {
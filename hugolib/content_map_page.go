@@ -15,7 +15,9 @@ package hugolib
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"path"
 	"path/filepath"
 	"strings"
@@ -51,6 +53,9 @@ func newPageMaps(h *HugoSites) *pageMaps {
 type pageMap struct {
 	s *Site
 	*contentMap
+
+	// Set in collectTermAliases.
+	termAliases termAliases
 }
 
 func (m *pageMap) Len() int {
@@ -84,6 +89,10 @@ func (m *pageMap) createMissingTaxonomyNodes() error {
 	return nil
 }
 
+// errBinaryContent is returned from newPageFromContentNode if the content
+// file contains binary data.
+var errBinaryContent = errors.New("content file contains binary data")
+
 func (m *pageMap) newPageFromContentNode(n *contentNode, parentBucket *pagesMapBucket, owner *pageState) (*pageState, error) {
 	if n.fi == nil {
 		panic("FileInfo must (currently) be set")
@@ -95,8 +104,19 @@ func (m *pageMap) newPageFromContentNode(n *contentNode, parentBucket *pagesMapB
 	}
 
 	meta := n.fi.Meta()
-	content := func() (hugio.ReadSeekCloser, error) {
-		return meta.Open()
+
+	r, err := meta.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	// We read the file anyway, so check for binary data before we create the page.
+	if files.IsBinaryContent(r) {
+		return nil, errBinaryContent
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
 	}
 
 	bundled := owner != nil
@@ -109,7 +129,7 @@ func (m *pageMap) newPageFromContentNode(n *contentNode, parentBucket *pagesMapB
 		s.PathSpec.MakePathsSanitized(sections)
 	}
 
-	metaProvider := &pageMeta{kind: kind, sections: sections, bundled: bundled, s: s, f: f}
+	metaProvider := &pageMeta{kind: kind, sections: sections, bundled: bundled, s: s, f: f, resourcesModTime: n.resourcesModTime}
 
 	ps, err := newPageBase(metaProvider)
 	if err != nil {
@@ -140,12 +160,6 @@ func (m *pageMap) newPageFromContentNode(n *contentNode, parentBucket *pagesMapB
 	}
 	ps.codeowners = owners
 
-	r, err := content()
-	if err != nil {
-		return nil, err
-	}
-	defer r.Close()
-
 	parseResult, err := pageparser.Parse(
 		r,
 		pageparser.Config{EnableEmoji: s.siteCfg.enableEmoji},
@@ -174,7 +188,7 @@ func (m *pageMap) newPageFromContentNode(n *contentNode, parentBucket *pagesMapB
 	ps.init.Add(func() (any, error) {
 		pp, err := newPagePaths(s, ps, metaProvider)
 		if err != nil {
-			return nil, err
+			return nil, ps.wrapError(err)
 		}
 
 		outputFormatsForPage := ps.m.outputFormats()
@@ -362,6 +376,12 @@ func (m *pageMap) assemblePages() error {
 		parentBucket = parent.p.bucket
 
 		n.p, err = m.newPageFromContentNode(n, parentBucket, nil)
+		if errors.Is(err, errBinaryContent) {
+			m.s.Log.Warnf("Content file %q contains binary data, it will be handled as a regular file.", n.fi.Meta().Filename)
+			m.deletePage(s)
+			err = m.s.copyContentFile(n.fi)
+			return err != nil
+		}
 		if err != nil {
 			return true
 		}
@@ -403,6 +423,15 @@ func (m *pageMap) assembleResources(s string, p *pageState, parentBucket *pagesM
 		case files.ContentClassContent:
 			var rp *pageState
 			rp, err = m.newPageFromContentNode(n, parentBucket, p)
+			if errors.Is(err, errBinaryContent) {
+				m.s.Log.Warnf("Content file %q contains binary data, it will be handled as a regular file.", meta.Filename)
+				meta.Classifier = files.ContentClassFile
+				r, err = m.newResource(n.fi, p)
+				if err != nil {
+					return true
+				}
+				break
+			}
 			if err != nil {
 				return true
 			}
@@ -543,6 +572,11 @@ func (m *pageMap) assembleTaxonomies() error {
 			n.p = m.s.newPage(n, parent.p.bucket, kind, title, sections...)
 		}
 
+		if kind == page.KindTerm {
+			// Redirect from the alias terms, relative to the term's URL.
+			n.p.m.aliases = append(n.p.m.aliases, m.termAliases.aliasesOf(n.viewInfo.name.plural, n.viewInfo.termKey)...)
+		}
+
 		if !m.s.shouldBuild(n.p) {
 			taxonomiesToDelete = append(taxonomiesToDelete, s)
 			return false
@@ -588,6 +622,10 @@ func (m *pageMap) attachPageToViews(s string, b *contentNode) {
 
 		for i, v := range vals {
 			termKey := m.s.getTaxonomyKey(v)
+			if canonical, found := m.termAliases[viewName.plural][termKey]; found {
+				// Merge the pages into the canonical term.
+				termKey, v = canonical, canonical
+			}
 
 			bv := &contentNode{
 				viewInfo: &contentBundleViewInfo{
@@ -713,6 +751,10 @@ func (m *pageMaps) AssemblePages() error {
 			return err
 		}
 
+		if err := pm.collectTermAliases(); err != nil {
+			return err
+		}
+
 		if err := pm.assemblePages(); err != nil {
 			return err
 		}
@@ -205,6 +205,24 @@ func TestDataDirArrayAtTopLevelOfFile(t *testing.T) {
 	doTestEquivalentDataDirs(t, equivDataDirs, expected)
 }
 
+func TestDataDirCSV(t *testing.T) {
+	t.Parallel()
+
+	var dd dataDir
+	dd.addSource("data/people.csv", "name,age\nalice,30\nbob,40\n")
+
+	expected :=
+		map[string]any{
+			"people": [][]string{
+				{"name", "age"},
+				{"alice", "30"},
+				{"bob", "40"},
+			},
+		}
+
+	doTestDataDir(t, dd, expected)
+}
+
 // Issue #892
 func TestDataDirMultipleSources(t *testing.T) {
 	t.Parallel()
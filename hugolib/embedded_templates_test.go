@@ -14,6 +14,7 @@
 package hugolib
 
 import (
+	"strings"
 	"testing"
 
 	qt "github.com/frankban/quicktest"
@@ -92,6 +93,175 @@ title: My Site
 `)
 }
 
+func TestInternalTemplatesFeeds(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+title = "My Site"
+-- content/posts/_index.md --
+---
+title: Posts
+---
+-- content/posts/p1.md --
+---
+title: P1
+tags: [hugo]
+---
+-- layouts/_default/single.html --
+{{ template "_internal/feeds.html" . }}
+-- layouts/_default/list.html --
+{{ template "_internal/feeds.html" . }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/posts/p1/index.html",
+		`<link rel="alternate" type="application/rss+xml" href="https://example.org/index.xml" title="My Site">`,
+		`<link rel="alternate" type="application/rss+xml" href="https://example.org/posts/index.xml" title="Posts | My Site">`,
+		`<link rel="alternate" type="application/rss+xml" href="https://example.org/tags/hugo/index.xml" title="hugo | My Site">`,
+	)
+	b.Assert(strings.Count(b.FileContent("public/posts/index.html"), "<link"), qt.Equals, 2)
+	b.Assert(strings.Count(b.FileContent("public/index.html"), "<link"), qt.Equals, 1)
+
+	// Only link to feeds that are rendered.
+	b = NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.Replace(files, "title = \"My Site\"", "title = \"My Site\"\n[outputs]\nterm = [\"html\"]", 1),
+		},
+	).Build()
+
+	b.AssertFileContent("public/posts/p1/index.html", "posts/index.xml")
+	b.Assert(b.FileContent("public/posts/p1/index.html"), qt.Not(qt.Contains), "tags/hugo/index.xml")
+}
+
+func TestInternalTemplatesJSONLD(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+title = "My Site"
+[params]
+logo = "logo.png"
+description = "Fish & Chips"
+-- content/posts/_index.md --
+---
+title: Posts
+---
+-- content/posts/p1.md --
+---
+title: P1
+description: "Fish &amp; <em>Chips</em>"
+author: Jo Nesbø
+images: ["p1.jpg"]
+date: 2022-08-01T10:00:00+02:00
+lastmod: 2022-08-02T10:00:00+02:00
+---
+-- layouts/index.html --
+{{ template "_internal/jsonld.html" . }}
+-- layouts/_default/single.html --
+{{ template "_internal/jsonld.html" . }}
+-- layouts/_default/list.html --
+{{ template "_internal/jsonld.html" . }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html",
+		`<script type="application/ld+json">{"@context":"https://schema.org","@graph":[{"@type":"Organization","logo":"https://example.org/logo.png","name":"My Site","url":"https://example.org/"},{"@type":"WebSite","description":"Fish \u0026 Chips","name":"My Site","url":"https://example.org/"}]}</script>`,
+	)
+	b.AssertFileContent("public/posts/p1/index.html",
+		`{"@type":"Article","author":[{"@type":"Person","name":"Jo Nesbø"}],"dateModified":"2022-08-02T10:00:00+02:00","datePublished":"2022-08-01T10:00:00+02:00","description":"Fish \u0026 Chips","headline":"P1","image":"https://example.org/p1.jpg","publisher":{"@type":"Organization"`,
+		`{"@type":"BreadcrumbList","itemListElement":[{"@type":"ListItem","item":"https://example.org/","name":"My Site","position":1},{"@type":"ListItem","item":"https://example.org/posts/","name":"Posts","position":2},{"@type":"ListItem","item":"https://example.org/posts/p1/","name":"P1","position":3}]}`,
+	)
+	b.AssertFileContent("public/posts/index.html", `"@type":"BreadcrumbList"`)
+	b.Assert(b.FileContent("public/posts/index.html"), qt.Not(qt.Contains), `"@type":"Article"`)
+}
+
+func TestInternalTemplatesDeprecation(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+[outputs]
+home = ["html", "headers"]
+[outputFormats.headers]
+mediaType = "text/x-headers"
+baseName = "_headers"
+isPlainText = true
+notAlternative = true
+-- content/api/v1.md --
+---
+title: V1
+deprecated: 2020-01-01T00:00:00Z
+sunset: 2099-06-30T12:00:00Z
+---
+-- content/api/v2.md --
+---
+title: V2
+---
+-- content/api/v3.md --
+---
+title: V3
+deprecated: true
+sunset: soon
+---
+-- content/api/v4.md --
++++
+title = "V4"
+deprecated = 2021-05-01
+sunset = 2099-01-01T00:00:00Z
++++
+-- layouts/_default/single.html --
+{{ template "_internal/deprecation.html" . }}|
+-- layouts/index.html --
+Home.
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/api/v1/index.html",
+		`<div class="deprecation-banner" role="note">`,
+		`This page was deprecated on <time datetime="2020-01-01T00:00:00&#43;00:00">January 1, 2020</time>.`,
+		`This page will be removed on <time datetime="2099-06-30T12:00:00&#43;00:00">June 30, 2099</time>.`,
+	)
+	b.Assert(b.FileContent("public/api/v2/index.html"), qt.Not(qt.Contains), "deprecation-banner")
+	b.Assert(b.FileContent("public/api/v3/index.html"), qt.Not(qt.Contains), "deprecation-banner")
+	b.AssertFileContent("public/api/v4/index.html",
+		`This page was deprecated on <time datetime="2021-05-01T00:00:00&#43;00:00">May 1, 2021</time>.`,
+		`This page will be removed on <time datetime="2099-01-01T00:00:00&#43;00:00">January 1, 2099</time>.`,
+	)
+	b.AssertFileContent("public/_headers", `
+/api/v1/
+  Deprecation: @1577836800
+  Sunset: Tue, 30 Jun 2099 12:00:00 GMT
+/api/v4/
+  Deprecation: @1619827200
+  Sunset: Thu, 01 Jan 2099 00:00:00 GMT
+`)
+	b.Assert(b.FileContent("public/_headers"), qt.Not(qt.Contains), "/api/v2/")
+	b.Assert(b.FileContent("public/_headers"), qt.Not(qt.Contains), "/api/v3/")
+}
+
 // Just some simple test of the embedded templates to avoid
 // https://github.com/gohugoio/hugo/issues/4757 and similar.
 func TestEmbeddedTemplates(t *testing.T) {
@@ -78,6 +78,9 @@ type HugoSites struct {
 	// The currently rendered Site.
 	currentSite *Site
 
+	// Set when doing an incremental build.
+	incremental *incrementalBuild
+
 	*deps.Deps
 
 	gitInfo       *gitInfo
@@ -96,6 +99,12 @@ type HugoSites struct {
 	skipRebuildForFilenamesMu sync.Mutex
 	skipRebuildForFilenames   map[string]bool
 
+	// The pages included in other pages' content, see ShortcodeWithPage.Include.
+	contentIncludes *contentIncludes
+
+	// The front matter defaults in _meta files in the content directories.
+	contentDirDefaults *contentDirDefaults
+
 	init *hugoSitesInit
 
 	workers    *para.Workers
@@ -296,6 +305,21 @@ func (h *HugoSites) PrintProcessingStats(w io.Writer) {
 	helpers.ProcessingStatsTable(w, stats...)
 }
 
+// PrintImageStats writes the image processing budget for the last build to w.
+func (h *HugoSites) PrintImageStats(w io.Writer) {
+	stats := make([]*helpers.ProcessingStats, len(h.Sites))
+	for i := 0; i < len(h.Sites); i++ {
+		stats[i] = h.Sites[i].PathSpec.ProcessingStats
+	}
+	helpers.ImageStatsTable(w, stats...)
+}
+
+// UnusedImages returns the processed images in the image cache (by default
+// resources/_gen/images) not used in the last build. This requires a full build.
+func (h *HugoSites) UnusedImages() ([]string, error) {
+	return h.Deps.ResourceSpec.UnusedImages()
+}
+
 // GetContentPage finds a Page with content given the absolute filename.
 // Returns nil if none found.
 func (h *HugoSites) GetContentPage(filename string) page.Page {
@@ -351,6 +375,8 @@ func newHugoSites(cfg deps.DepsCfg, sites ...*Site) (*HugoSites, error) {
 		workers:                 workers,
 		numWorkers:              numWorkers,
 		skipRebuildForFilenames: make(map[string]bool),
+		contentIncludes:         &contentIncludes{},
+		contentDirDefaults:      &contentDirDefaults{},
 		init: &hugoSitesInit{
 			data:         lazy.New(),
 			layouts:      lazy.New(),
@@ -821,6 +847,10 @@ func (h *HugoSites) removePageByFilename(filename string) {
 		})
 		return nil
 	})
+
+	for _, s := range h.Sites {
+		s.scheduled.removeByFilename(filename)
+	}
 }
 
 func (h *HugoSites) createPageCollections() error {
@@ -850,12 +880,12 @@ func (h *HugoSites) createPageCollections() error {
 func (s *Site) preparePagesForRender(isRenderingSite bool, idx int) error {
 	var err error
 	s.pageMap.withEveryBundlePage(func(p *pageState) bool {
-		if err = p.initOutputFormat(isRenderingSite, idx); err != nil {
-			return true
+		if err == nil {
+			err = p.initOutputFormat(isRenderingSite, idx)
 		}
-		return false
+		return err != nil
 	})
-	return nil
+	return err
 }
 
 // Pages returns all pages for all sites.
@@ -945,7 +975,8 @@ func (h *HugoSites) handleDataFile(r source.File) error {
 				"higher precedence %T data already in the data tree", data, r.Path(), higherPrecedentData)
 		}
 
-	case []any:
+	case []any, [][]string:
+		// [][]string are the records in a CSV file.
 		if higherPrecedentData == nil {
 			current[r.BaseFileName()] = data
 		} else {
@@ -29,6 +29,7 @@ import (
 
 	"github.com/gohugoio/hugo/common/para"
 	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/resources/page"
 	"github.com/gohugoio/hugo/resources/postpub"
 
 	"github.com/spf13/afero"
@@ -162,6 +163,12 @@ func (h *HugoSites) Build(config BuildCfg, events ...fsnotify.Event) error {
 
 		h.Log.Printf("\nTemplate Metrics:\n\n")
 		h.Log.Println(b.String())
+
+		b.Reset()
+		h.Metrics.WritePageMetrics(&b)
+
+		h.Log.Printf("\nSlowest Pages:\n\n")
+		h.Log.Println(b.String())
 	}
 
 	select {
@@ -185,6 +192,12 @@ func (h *HugoSites) Build(config BuildCfg, events ...fsnotify.Event) error {
 		return fmt.Errorf("logged %d error(s)", errorCount)
 	}
 
+	if h.incremental != nil && !config.SkipRender {
+		if err := h.incremental.save(); err != nil {
+			return fmt.Errorf("failed to save incremental build manifest: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -194,6 +207,11 @@ func (h *HugoSites) Build(config BuildCfg, events ...fsnotify.Event) error {
 func (h *HugoSites) initSites(config *BuildCfg) error {
 	h.reset(config)
 
+	// All content is processed again.
+	for _, s := range h.Sites {
+		s.scheduled.reset()
+	}
+
 	if config.NewConfig != nil {
 		if err := h.createSitesFromConfig(config.NewConfig); err != nil {
 			return err
@@ -276,6 +294,25 @@ func (h *HugoSites) render(config *BuildCfg) error {
 	siteRenderContext := &siteRenderContext{cfg: config, multihost: h.multihost}
 
 	if !config.PartialReRender {
+		for _, s := range h.Sites {
+			if s.isEnabled(page.KindPage) && len(s.RegularPages()) == 0 {
+				s.Log.Warnf("No content found for language %q. Add some with \"hugo new posts/my-first-post.md\".", s.Language().Lang)
+			}
+			s.PathSpec.ProcessingStats.TaxonomyTerms = uint64(s.Taxonomies().TermCount())
+		}
+
+		h.incremental = nil
+		if h.Cfg.GetBool("incremental") && !h.running {
+			if option := h.incrementalUnsupportedBy(); option != "" {
+				h.Log.Warnf("Ignoring incremental: %s needs every page to be rendered.", option)
+			} else {
+				var err error
+				if h.incremental, err = h.newIncrementalBuild(); err != nil {
+					return fmt.Errorf("failed to init incremental build: %w", err)
+				}
+			}
+		}
+
 		h.renderFormats = output.Formats{}
 		h.withSite(func(s *Site) error {
 			s.initRenderFormats()
@@ -310,6 +347,12 @@ func (h *HugoSites) render(config *BuildCfg) error {
 					}
 				}
 
+				if siteRenderContext.sitesOutIdx == 0 && !config.PartialReRender {
+					if err := h.checkOutputFilenames(); err != nil {
+						return err
+					}
+				}
+
 				if !config.SkipRender {
 					if config.PartialReRender {
 						if err := s.renderPages(siteRenderContext); err != nil {
@@ -345,6 +388,10 @@ func (h *HugoSites) postProcess() error {
 		return err
 	}
 
+	if err := h.writeScheduledChanges(); err != nil {
+		return err
+	}
+
 	// This will only be set when js.Build have been triggered with
 	// imports that resolves to the project or a module.
 	// Write a jsconfig.json file to the project's /asset directory
@@ -489,16 +536,21 @@ func (h *HugoSites) writeBuildStats() error {
 		return err
 	}
 
-	filename := filepath.Join(h.WorkingDir, "hugo_stats.json")
+	return h.writeWorkingDirFile("hugo_stats.json", js)
+}
+
+// writeWorkingDirFile writes b to the file with the given name in the project root.
+func (h *HugoSites) writeWorkingDirFile(name string, b []byte) error {
+	filename := filepath.Join(h.WorkingDir, name)
 
 	// Make sure it's always written to the OS fs.
-	if err := afero.WriteFile(hugofs.Os, filename, js, 0666); err != nil {
+	if err := afero.WriteFile(hugofs.Os, filename, b, 0666); err != nil {
 		return err
 	}
 
 	// Write to the destination as well if it's a in-memory fs.
 	if !hugofs.IsOsFs(h.Fs.Source) {
-		if err := afero.WriteFile(h.Fs.WorkingDirWritable, filename, js, 0666); err != nil {
+		if err := afero.WriteFile(h.Fs.WorkingDirWritable, filename, b, 0666); err != nil {
 			return err
 		}
 	}
@@ -645,3 +645,50 @@ title: "A page"
 
 	b.CreateSites().BuildFail(BuildCfg{})
 }
+
+func TestRenderTimeout(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+renderTimeout = "200ms"
+disableKinds = ["taxonomy", "term", "section", "RSS", "sitemap"]
+-- layouts/index.html --
+Home.
+{{ partial "loop.html" . }}
+-- layouts/partials/loop.html --
+{{ range seq 2000 }}{{ range seq 2000 }}{{ range seq 2000 }}{{ add 1 1 }}{{ end }}{{ end }}{{ end }}
+`
+
+	b, err := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `render of "home" timed out after 200ms`)
+	b.Assert(err.Error(), qt.Contains, `stopped: context deadline exceeded`)
+	fe := b.AssertIsFileError(err)
+	b.Assert(fe.Position().Filename, qt.Contains, filepath.FromSlash("layouts/index.html"))
+}
+
+func TestErrorInvalidUTF8Content(t *testing.T) {
+	t.Parallel()
+
+	files := "-- config.toml --\n-- content/p1.md --\n---\ntitle: \"P1\"\n---\n\nLine 1.\nLine \xe62.\n"
+
+	b, err := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	fe := b.AssertIsFileError(err)
+	b.Assert(fe.Position().LineNumber, qt.Equals, 6)
+	b.Assert(fe.Position().ColumnNumber, qt.Equals, 6)
+	b.Assert(fe.Error(), qt.Contains, "invalid UTF-8 encoding")
+}
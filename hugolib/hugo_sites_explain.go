@@ -0,0 +1,190 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/gohugoio/hugo/identity"
+	"github.com/gohugoio/hugo/tpl"
+)
+
+// OutputExplanation describes the inputs that produced a published page.
+// All paths are relative to the project, with Unix style slashes,
+// e.g. content/posts/foo.md and layouts/_default/single.html.
+type OutputExplanation struct {
+	// The published file, relative to the publish directory.
+	TargetPath   string
+	OutputFormat string
+
+	// The content file, empty if the page has none, e.g. a home page
+	// without a content/_index.md.
+	Page string
+
+	// The layout used and the templates it depends on (base templates and partials).
+	Templates []string
+
+	// The templates (shortcodes and render hooks), content files and pages
+	// the rendered content depends on. This is only tracked in server mode.
+	Dependencies []string
+
+	// The names of the page resources.
+	Resources []string
+}
+
+// ExplainOutput describes the inputs used to produce the page published to
+// targetPath, e.g. /posts/foo/ or /posts/foo/index.html.
+// It returns nil if no page is published to targetPath.
+// The caller must hold the build lock, see LockBuild.
+func (h *HugoSites) ExplainOutput(targetPath string) *OutputExplanation {
+	if targetPath == "" || strings.HasSuffix(targetPath, "/") {
+		targetPath += "index.html"
+	}
+	targetPath = publishManifestFilename(targetPath)
+
+	var e *OutputExplanation
+
+	for _, s := range h.Sites {
+		s.pageMap.withEveryBundlePage(func(p *pageState) bool {
+			for _, po := range p.pageOutputs {
+				if !po.render || publishManifestFilename(po.targetPaths().TargetFilename) != targetPath {
+					continue
+				}
+				e = p.explainOutput(po)
+				e.TargetPath = targetPath
+				return true
+			}
+			return false
+		})
+		if e != nil {
+			break
+		}
+	}
+
+	return e
+}
+
+func (p *pageState) explainOutput(po *pageOutput) *OutputExplanation {
+	e := &OutputExplanation{
+		OutputFormat: po.f.Name,
+	}
+
+	if !p.File().IsZero() {
+		e.Page = explainPath(p.GetIdentity())
+	}
+
+	var templ tpl.Template
+	if selfLayout := p.selfLayoutForOutput(po.f); selfLayout != "" {
+		templ, _ = p.s.Tmpl().Lookup(selfLayout)
+	} else {
+		templ, _, _ = p.s.Tmpl().LookupLayout(p.getLayoutDescriptor(), po.f)
+	}
+	if provider, ok := templ.(identity.Provider); ok {
+		e.Templates = collectExplainPaths(provider)
+	}
+
+	var deps []identity.Provider
+	if po.cp != nil && po.cp.dependencyTracker != nil {
+		deps = append(deps, po.cp.dependencyTracker)
+	}
+	if p.shortcodeState != nil {
+		for _, sc := range p.shortcodeState.shortcodes {
+			for _, templ := range sc.templs {
+				if provider, ok := templ.(identity.Provider); ok {
+					deps = append(deps, provider)
+				}
+			}
+		}
+	}
+	e.Dependencies = collectExplainPaths(deps...)
+
+	for _, r := range p.ownResources() {
+		e.Resources = append(e.Resources, r.Name())
+	}
+
+	return e
+}
+
+// collectExplainPaths collects the paths of the path identities in the
+// dependency graph of the given providers, sorted.
+func collectExplainPaths(providers ...identity.Provider) []string {
+	seen := make(map[identity.Identity]bool)
+	var paths []string
+
+	var collect func(provider identity.Provider)
+	collect = func(provider identity.Provider) {
+		id := provider.GetIdentity()
+		if seen[id] {
+			return
+		}
+		seen[id] = true
+
+		if s := explainPath(id); s != "" {
+			paths = append(paths, s)
+		}
+
+		if ip, ok := provider.(identity.IdentitiesProvider); ok {
+			for _, v := range ip.GetIdentities() {
+				collect(v)
+			}
+		}
+	}
+
+	for _, provider := range providers {
+		collect(provider)
+	}
+
+	sort.Strings(paths)
+
+	return paths
+}
+
+func explainPath(id identity.Identity) string {
+	if pid, ok := id.(identity.PathIdentity); ok {
+		return path.Join(pid.Type, strings.ReplaceAll(pid.Path, "\\", "/"))
+	}
+	return ""
+}
+
+// Write writes a plain text report of e to w.
+func (e *OutputExplanation) Write(w io.Writer) {
+	fmt.Fprintf(w, "%s (%s)\n", e.TargetPath, e.OutputFormat)
+
+	page := e.Page
+	if page == "" {
+		page = "(none)"
+	}
+	fmt.Fprintf(w, "\nPage:\n  %s\n", page)
+
+	section := func(title string, items []string) {
+		if len(items) == 0 {
+			return
+		}
+		fmt.Fprintf(w, "\n%s:\n", title)
+		for _, item := range items {
+			fmt.Fprintf(w, "  %s\n", item)
+		}
+	}
+
+	section("Templates", e.Templates)
+	section("Dependencies", e.Dependencies)
+	section("Resources", e.Resources)
+
+	// We don't track .Site.Data access, so be explicit about it.
+	fmt.Fprintf(w, "\nData files:\n  not tracked\n")
+}
@@ -0,0 +1,78 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"bytes"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestExplainOutput(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+disableKinds = ["taxonomy", "term", "sitemap", "RSS"]
+-- content/posts/p1/index.md --
+---
+title: "P1"
+---
+{{< sc >}}
+-- content/posts/p1/data.json --
+{}
+-- layouts/_default/baseof.html --
+{{ block "main" . }}{{ end }}
+-- layouts/_default/single.html --
+{{ define "main" }}{{ partial "header.html" . }}{{ .Content }}{{ end }}
+-- layouts/_default/list.html --
+List.
+-- layouts/partials/header.html --
+Header.
+-- layouts/shortcodes/sc.html --
+Shortcode.
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			Running:     true,
+		},
+	).Build()
+
+	e := b.H.ExplainOutput("/posts/p1/")
+	b.Assert(e, qt.Not(qt.IsNil))
+	b.Assert(e.TargetPath, qt.Equals, "posts/p1/index.html")
+	b.Assert(e.OutputFormat, qt.Equals, "HTML")
+	b.Assert(e.Page, qt.Equals, "content/posts/p1/index.md")
+	b.Assert(e.Templates, qt.DeepEquals, []string{
+		"layouts/_default/baseof.html",
+		"layouts/_default/single.html",
+		"layouts/partials/header.html",
+	})
+	b.Assert(e.Dependencies, qt.Contains, "layouts/shortcodes/sc.html")
+	b.Assert(e.Resources, qt.DeepEquals, []string{"data.json"})
+
+	var buf bytes.Buffer
+	e.Write(&buf)
+	b.Assert(buf.String(), qt.Contains, "Page:\n  content/posts/p1/index.md\n")
+	b.Assert(buf.String(), qt.Contains, "Data files:\n  not tracked\n")
+
+	b.Assert(b.H.ExplainOutput("/posts/p1/index.html").Page, qt.Equals, "content/posts/p1/index.md")
+	b.Assert(b.H.ExplainOutput("/").Page, qt.Equals, "")
+	b.Assert(b.H.ExplainOutput("/nope/"), qt.IsNil)
+}
@@ -0,0 +1,333 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gohugoio/hugo/cache/filecache"
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/helpers"
+	"github.com/gohugoio/hugo/resources/page"
+	"github.com/gohugoio/hugo/resources/resource"
+	"github.com/gohugoio/hugo/tpl"
+	"github.com/spf13/afero"
+)
+
+// buildManifest is stored in the file cache between incremental builds.
+type buildManifest struct {
+	// Fingerprint of the inputs shared by all pages: the configuration, the
+	// templates, data, i18n and assets, and the source and resources of every
+	// page.
+	Global string
+
+	// Fingerprint of the inputs of every rendered page output, keyed by
+	// language and target path.
+	Pages map[string]string
+}
+
+// incrementalBuild keeps track of the page fingerprints in a build with
+// incremental set. We don't track which pages a page depends on (e.g. via
+// .GetPage or .Translations), so a regular page can only skip rendering when
+// no page source, resource or other shared input changed since the last
+// build, its layout is the same and its output still exists in the publish
+// directory. Templates reading files or remote data disable skipping, as do
+// those using the current time. List pages are always rendered.
+type incrementalBuild struct {
+	h     *HugoSites
+	cache *filecache.Cache
+	id    string
+
+	globalUnchanged bool
+
+	prev buildManifest
+
+	mu   sync.Mutex
+	next buildManifest
+}
+
+func (h *HugoSites) newIncrementalBuild() (*incrementalBuild, error) {
+	b := &incrementalBuild{
+		h:     h,
+		cache: h.FileCaches.IncrementalCache(),
+		id:    "manifest_" + helpers.MD5String(h.Cfg.GetString("publishDir")) + ".json",
+		next:  buildManifest{Pages: make(map[string]string)},
+	}
+
+	global, err := b.globalFingerprint()
+	if err != nil {
+		return nil, err
+	}
+	b.next.Global = global
+
+	_, data, err := b.cache.GetBytes(b.id)
+	if err != nil {
+		return nil, err
+	}
+	if data != nil {
+		if err := json.Unmarshal(data, &b.prev); err != nil {
+			h.Log.Warnf("Failed to read the incremental build manifest, doing a full render: %s", err)
+			b.prev = buildManifest{}
+		}
+	}
+
+	external, err := templatesReadExternalInput(h.BaseFs.Layouts.Fs)
+	if err != nil {
+		return nil, err
+	}
+	if external {
+		h.Log.Infoln("Incremental build: the templates read files, remote data or the current time, rendering all pages.")
+	}
+
+	b.globalUnchanged = !external && b.prev.Global == b.next.Global
+
+	return b, nil
+}
+
+// incrementalUnsupportedBy returns the first enabled option that needs
+// every page to be rendered, or an empty string if there is none. A page
+// skipped in an incremental build isn't written and doesn't touch its
+// processed images, so it would be missing from the build stats and the
+// publish manifest, and its images would be reported as unused and removed
+// from the cache.
+func (h *HugoSites) incrementalUnsupportedBy() string {
+	switch {
+	case h.ResourceSpec.BuildConfig.WriteStats:
+		return "build.writeStats"
+	case h.ResourceSpec.BuildConfig.WriteManifest:
+		return "build.writeManifest"
+	case h.Cfg.GetString("compare") != "":
+		return "--compare"
+	case h.Cfg.GetBool("gc"):
+		return "--gc"
+	case h.Cfg.GetBool("gcDryRun"):
+		return "--gcDryRun"
+	case h.Cfg.GetBool("printUnusedImages"):
+		return "--printUnusedImages"
+	}
+	return ""
+}
+
+// isUnchanged records the fingerprint of p rendered with templ to targetPath
+// and reports whether we can skip rendering it.
+func (b *incrementalBuild) isUnchanged(p *pageState, templ tpl.Template, targetPath string) bool {
+	if p.Kind() != page.KindPage || p.File().IsZero() {
+		return false
+	}
+
+	fp, err := b.pageFingerprint(p, templ)
+	if err != nil {
+		p.s.Log.Warnf("Failed to fingerprint page %q: %s", p.pathOrTitle(), err)
+		return false
+	}
+
+	key := p.Language().Lang + ":" + targetPath
+
+	b.mu.Lock()
+	b.next.Pages[key] = fp
+	prev := b.prev.Pages[key]
+	b.mu.Unlock()
+
+	if !b.globalUnchanged || prev != fp {
+		return false
+	}
+
+	exists, _ := helpers.Exists(targetPath, p.s.BaseFs.PublishFs)
+
+	return exists
+}
+
+// save writes the manifest to the file cache for the next build.
+func (b *incrementalBuild) save() error {
+	_, w, err := b.cache.WriteCloser(b.id)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	return json.NewEncoder(w).Encode(b.next)
+}
+
+func (b *incrementalBuild) globalFingerprint() (string, error) {
+	h := md5.New()
+
+	writeHashValue(h, b.h.Cfg.Get(""))
+
+	fss := []afero.Fs{
+		b.h.BaseFs.Layouts.Fs,
+		b.h.BaseFs.Data.Fs,
+		b.h.BaseFs.I18n.Fs,
+		b.h.BaseFs.Assets.Fs,
+	}
+
+	for _, fs := range fss {
+		if err := hashFiles(h, fs); err != nil {
+			return "", err
+		}
+	}
+
+	// Any page may render any other page's content and resources, so
+	// every source goes into the shared fingerprint.
+	var err error
+	for _, s := range b.h.Sites {
+		s.pageMap.withEveryBundlePage(func(p *pageState) bool {
+			if p.File().IsZero() {
+				return false
+			}
+			fmt.Fprintf(h, "%s|%s|%s|", p.Language().Lang, p.Kind(), p.File().Filename())
+			writeHashValue(h, p.Params())
+			h.Write(p.source.parsed.Input())
+			err = hashResources(h, p.ownResources())
+			return err != nil
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func (b *incrementalBuild) pageFingerprint(p *pageState, templ tpl.Template) (string, error) {
+	h := md5.New()
+
+	io.WriteString(h, templ.Name())
+	h.Write(p.source.parsed.Input())
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func hashResources(h hash.Hash, resources resource.Resources) error {
+	for _, r := range resources {
+		io.WriteString(h, r.Name())
+		rr, ok := r.(resource.ReadSeekCloserResource)
+		if !ok {
+			continue
+		}
+		f, err := rr.ReadSeekCloser()
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func hashFiles(h hash.Hash, fs afero.Fs) error {
+	return afero.Walk(fs, "", func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		f, err := fs.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		io.WriteString(h, path)
+		_, err = io.Copy(h, f)
+		return err
+	})
+}
+
+var (
+	templateActionRe = regexp.MustCompile(`(?s){{.*?}}`)
+
+	// Template functions with output that depends on more than the project
+	// files we fingerprint.
+	externalInputFuncsRe = regexp.MustCompile(`\b(readFile|readDir|fileExists|getJSON|getCSV|now)\b|\b(os|data|resources|time)\.(ReadFile|ReadDir|FileExists|Stat|GetJSON|GetCSV|GetRemote|Now)\b`)
+)
+
+// templatesReadExternalInput reports whether any template in fs calls a
+// function in externalInputFuncsRe.
+func templatesReadExternalInput(fs afero.Fs) (bool, error) {
+	var found bool
+	err := afero.Walk(fs, "", func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if found || info.IsDir() {
+			return nil
+		}
+		b, err := afero.ReadFile(fs, path)
+		if err != nil {
+			return err
+		}
+		for _, action := range templateActionRe.FindAll(b, -1) {
+			if externalInputFuncsRe.Match(action) {
+				found = true
+				break
+			}
+		}
+		return nil
+	})
+
+	return found, err
+}
+
+// writeHashValue writes a stable representation of the plain values in v,
+// e.g. configuration and front matter, to h. Other values are skipped.
+func writeHashValue(h io.Writer, v any) {
+	switch vv := v.(type) {
+	case maps.Params:
+		writeHashValue(h, map[string]any(vv))
+	case map[string]any:
+		keys := make([]string, 0, len(vv))
+		for k := range vv {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		io.WriteString(h, "{")
+		for _, k := range keys {
+			fmt.Fprintf(h, "%q:", k)
+			writeHashValue(h, vv[k])
+		}
+		io.WriteString(h, "}")
+	case []any:
+		io.WriteString(h, "[")
+		for _, vvv := range vv {
+			writeHashValue(h, vvv)
+		}
+		io.WriteString(h, "]")
+	case []string:
+		fmt.Fprintf(h, "%q", vv)
+	case string, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		fmt.Fprintf(h, "%T%v,", vv, vv)
+	case time.Time:
+		fmt.Fprintf(h, "%s,", vv.Format(time.RFC3339Nano))
+	}
+}
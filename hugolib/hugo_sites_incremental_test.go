@@ -0,0 +1,227 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"fmt"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/deps"
+	"github.com/gohugoio/hugo/hugofs"
+)
+
+func TestIncrementalBuild(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap"]
+incremental = true
+-- content/s/p1.md --
+---
+title: P1
+---
+P1 content.
+-- content/s/p2.md --
+---
+title: P2
+---
+P2 content.
+-- layouts/_default/single.html --
+Single: {{ .Title }}|{{ .Content }}
+-- layouts/_default/list.html --
+List: {{ range .Site.RegularPages }}{{ .Title }}|{{ end }}
+`
+
+	b, rebuild := newIncrementalTestBuilder(t, files)
+
+	// Home, section and the two pages.
+	b.AssertRenderCountPage(4)
+
+	// Nothing changed, only the list pages are rendered.
+	rebuild()
+	b.AssertRenderCountPage(2)
+	b.AssertFileContent("public/s/p1/index.html", "Single: P1|<p>P1 content.</p>")
+
+	// The content of P1 changed, and any page may depend on it.
+	b.writeSource(b.absFilename("content/s/p1.md"), "---\ntitle: P1\n---\nP1 edited.")
+	rebuild()
+	b.AssertRenderCountPage(4)
+	b.AssertFileContent("public/s/p1/index.html", "Single: P1|<p>P1 edited.</p>")
+
+	// The front matter of P2 changed, which may affect every page.
+	b.writeSource(b.absFilename("content/s/p2.md"), "---\ntitle: P2 edited\n---\nP2 content.")
+	rebuild()
+	b.AssertRenderCountPage(4)
+	b.AssertFileContent("public/index.html", "List: P1|P2 edited|")
+
+	// A template changed.
+	b.writeSource(b.absFilename("layouts/_default/single.html"), "Single edited: {{ .Title }}")
+	rebuild()
+	b.AssertRenderCountPage(4)
+	b.AssertFileContent("public/s/p2/index.html", "Single edited: P2 edited")
+
+	// The output of P1 is gone.
+	b.Assert(b.fs.PublishDir.Remove("s/p1/index.html"), qt.IsNil)
+	rebuild()
+	b.AssertRenderCountPage(3)
+	b.AssertFileContent("public/s/p1/index.html", "Single edited: P1")
+}
+
+func newIncrementalTestBuilder(t *testing.T, files string) (*IntegrationTestBuilder, func()) {
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	return b, func() {
+		b.Helper()
+		var err error
+		b.H, err = NewHugoSites(deps.DepsCfg{Cfg: b.H.Cfg, Fs: b.fs, Logger: b.H.Log})
+		b.Assert(err, qt.IsNil)
+		b.counters = &testCounters{}
+		b.Assert(b.H.Build(BuildCfg{testCounters: b.counters}), qt.IsNil)
+	}
+}
+
+func TestIncrementalBuildOtherPageChanged(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["home", "section", "taxonomy", "term", "RSS", "sitemap"]
+incremental = true
+-- content/a.md --
+---
+title: A
+---
+A content.
+-- content/b.md --
+---
+title: B
+---
+B content.
+-- layouts/_default/single.html --
+{{ .Title }}|{{ with .Site.GetPage "b" }}Summary: {{ .Summary }}{{ end }}
+`
+
+	b, rebuild := newIncrementalTestBuilder(t, files)
+	b.AssertRenderCountPage(2)
+
+	rebuild()
+	b.AssertRenderCountPage(0)
+
+	b.writeSource(b.absFilename("content/b.md"), "---\ntitle: B\n---\nB edited.")
+	rebuild()
+	b.AssertRenderCountPage(2)
+	b.AssertFileContent("public/a/index.html", "A|Summary: B edited.")
+}
+
+func TestIncrementalBuildExternalInput(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["home", "section", "taxonomy", "term", "RSS", "sitemap"]
+incremental = true
+-- content/a.md --
+---
+title: A
+---
+-- data.txt --
+Data 1
+-- layouts/_default/single.html --
+{{ .Title }}|{{ readFile "data.txt" }}
+`
+
+	b, rebuild := newIncrementalTestBuilder(t, files)
+
+	b.writeSource(b.absFilename("data.txt"), "Data 2")
+	rebuild()
+	b.AssertRenderCountPage(1)
+	b.AssertFileContent("public/a/index.html", "A|Data 2")
+}
+
+func TestIncrementalBuildDisabledByOptionsNeedingAllPages(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name   string
+		config string
+		option string
+	}{
+		{"writeStats", "[build]\nwriteStats = true", "build.writeStats"},
+		{"writeManifest", "[build]\nwriteManifest = true", "build.writeManifest"},
+		{"compare", `compare = "hugo_manifest.json"`, "--compare"},
+		{"gc", "gc = true", "--gc"},
+		{"gcDryRun", "gcDryRun = true", "--gcDryRun"},
+		{"printUnusedImages", "printUnusedImages = true", "--printUnusedImages"},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			files := fmt.Sprintf(`
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+incremental = true
+%s
+-- content/p1/index.md --
+---
+title: P1
+---
+-- content/p1/pixel.png --
+iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYPhfDwAChwGA60e6kgAAAABJRU5ErkJggg==
+-- layouts/_default/single.html --
+{{ .Title }}|{{ with .Resources.Get "pixel.png" }}{{ (.Resize "2x").RelPermalink }}{{ end }}
+-- layouts/_default/list.html --
+List.
+`, test.config)
+
+			b, rebuild := newIncrementalTestBuilder(t, files)
+
+			// Start recording the files written in the next build.
+			if r, ok := b.fs.PublishDir.(hugofs.Reseter); ok {
+				r.Reset()
+			}
+
+			rebuild()
+
+			// Home and P1.
+			b.AssertRenderCountPage(2)
+			b.AssertLogContains("Ignoring incremental: " + test.option + " needs every page to be rendered.")
+
+			// The processed image of P1 was used in this build.
+			unused, err := b.H.GCDryRun()
+			b.Assert(err, qt.IsNil)
+			b.Assert(unused, qt.HasLen, 0)
+			unusedImages, err := b.H.UnusedImages()
+			b.Assert(err, qt.IsNil)
+			b.Assert(unusedImages, qt.HasLen, 0)
+
+			if test.name == "writeManifest" {
+				m, err := b.H.CreatePublishManifest()
+				b.Assert(err, qt.IsNil)
+				b.Assert(m.Files["p1/index.html"].Source, qt.Equals, "p1/index.md")
+			}
+		})
+	}
+}
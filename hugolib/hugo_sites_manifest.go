@@ -0,0 +1,339 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gohugoio/hugo/helpers"
+	"github.com/spf13/afero"
+)
+
+// PublishManifestFilename is the name of the manifest written to the project
+// root when build.writeManifest is enabled.
+const PublishManifestFilename = "hugo_manifest.json"
+
+// PublishManifest lists the files published by a build, i.e. the files
+// written to the publish directory and the static files.
+type PublishManifest struct {
+	// Fingerprints of the configuration, the templates and the content files
+	// the files were built from. These are used to tell why the output changed
+	// between two builds.
+	Config    string `json:"config,omitempty"`
+	Templates string `json:"templates,omitempty"`
+	Content   string `json:"content,omitempty"`
+
+	// Keyed by the path relative to the publish directory, with Unix style slashes.
+	Files map[string]PublishedFile `json:"files"`
+}
+
+// PublishedFile holds the size and the MD5 hash of a published file.
+type PublishedFile struct {
+	Size int64  `json:"size"`
+	MD5  string `json:"md5"`
+
+	// The content file this file was rendered from, if any, relative to
+	// the content directory, and its MD5 hash.
+	Source    string `json:"source,omitempty"`
+	SourceMD5 string `json:"sourceMD5,omitempty"`
+}
+
+// The causes of a change in the build output, see PublishManifestDiff.
+const (
+	ChangeCauseContent  = "content"
+	ChangeCauseTemplate = "template"
+	ChangeCauseConfig   = "config"
+	ChangeCauseOther    = "other"
+)
+
+// The status of a file in a PublishManifestDiff.
+const (
+	FileStatusAdded   = "added"
+	FileStatusRemoved = "removed"
+	FileStatusChanged = "changed"
+)
+
+// PublishedFileDiff describes a file that differs between two builds.
+type PublishedFileDiff struct {
+	Filename string
+	Status   string
+
+	// The most likely cause of the change, one of the ChangeCause constants.
+	// This is a best effort guess, e.g. a list page that changed in a build
+	// with both template and content changes is reported as a template change.
+	Cause string
+}
+
+// PublishManifestDiff holds the files that differ between two builds, sorted
+// by cause and filename.
+type PublishManifestDiff []PublishedFileDiff
+
+// ReadPublishManifest reads a manifest written by WritePublishManifest.
+func ReadPublishManifest(fs afero.Fs, filename string) (PublishManifest, error) {
+	var m PublishManifest
+	b, err := afero.ReadFile(fs, filename)
+	if err != nil {
+		return m, err
+	}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return m, fmt.Errorf("failed to read publish manifest %q: %w", filename, err)
+	}
+	return m, nil
+}
+
+// Diff returns the files that differ between prev and m.
+func (m PublishManifest) Diff(prev PublishManifest) PublishManifestDiff {
+	var diff PublishManifestDiff
+
+	add := func(filename, status string) {
+		diff = append(diff, PublishedFileDiff{Filename: filename, Status: status, Cause: m.causeOfChange(prev, filename)})
+	}
+
+	for filename, f := range m.Files {
+		prevf, found := prev.Files[filename]
+		if !found {
+			add(filename, FileStatusAdded)
+		} else if f.Size != prevf.Size || f.MD5 != prevf.MD5 {
+			add(filename, FileStatusChanged)
+		}
+	}
+
+	for filename := range prev.Files {
+		if _, found := m.Files[filename]; !found {
+			add(filename, FileStatusRemoved)
+		}
+	}
+
+	sort.Slice(diff, func(i, j int) bool {
+		ci, cj := changeCauseOrder(diff[i].Cause), changeCauseOrder(diff[j].Cause)
+		if ci != cj {
+			return ci < cj
+		}
+		return diff[i].Filename < diff[j].Filename
+	})
+
+	return diff
+}
+
+func (m PublishManifest) causeOfChange(prev PublishManifest, filename string) string {
+	f, prevf := m.Files[filename], prev.Files[filename]
+
+	// Manifests written by older Hugo versions have no fingerprints.
+	changed := func(a, b string) bool {
+		return a != "" && b != "" && a != b
+	}
+
+	switch {
+	case (f.Source != "" || prevf.Source != "") && f.SourceMD5 != prevf.SourceMD5:
+		return ChangeCauseContent
+	case changed(m.Templates, prev.Templates):
+		return ChangeCauseTemplate
+	case changed(m.Config, prev.Config):
+		return ChangeCauseConfig
+	case changed(m.Content, prev.Content):
+		// E.g. a list page.
+		return ChangeCauseContent
+	default:
+		return ChangeCauseOther
+	}
+}
+
+func changeCauseOrder(cause string) int {
+	switch cause {
+	case ChangeCauseContent:
+		return 0
+	case ChangeCauseTemplate:
+		return 1
+	case ChangeCauseConfig:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// Write writes a report of the differences grouped by cause to w.
+func (d PublishManifestDiff) Write(w io.Writer) {
+	if len(d) == 0 {
+		fmt.Fprintln(w, "No differences found.")
+		return
+	}
+
+	var cause string
+	for _, f := range d {
+		if f.Cause != cause {
+			cause = f.Cause
+			counts := make(map[string]int)
+			for _, ff := range d {
+				if ff.Cause == cause {
+					counts[ff.Status]++
+				}
+			}
+			fmt.Fprintf(w, "\nCause: %s change (%d added, %d removed, %d changed)\n", cause, counts[FileStatusAdded], counts[FileStatusRemoved], counts[FileStatusChanged])
+		}
+		fmt.Fprintf(w, "  %-8s %s\n", f.Status, f.Filename)
+	}
+}
+
+// WritePublishManifest writes m, see CreatePublishManifest, to the project root.
+func (h *HugoSites) WritePublishManifest(m PublishManifest) error {
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return h.writeWorkingDirFile(PublishManifestFilename, b)
+}
+
+// publishedFilenamesProvider is implemented by the publish filesystem when
+// it records the files written, see hugofs.NewCreateCountingFs.
+type publishedFilenamesProvider interface {
+	Filenames() []string
+}
+
+// CreatePublishManifest creates a manifest of the files published by this
+// build, to be written with WritePublishManifest or compared with a previous
+// manifest with Diff.
+// This should be called when all files are published, including the static files.
+func (h *HugoSites) CreatePublishManifest() (PublishManifest, error) {
+	m := PublishManifest{Files: make(map[string]PublishedFile)}
+
+	sources, err := h.fingerprintPublishManifest(&m)
+	if err != nil {
+		return m, err
+	}
+
+	addFile := func(fs afero.Fs, path, filename string) error {
+		f, err := fs.Open(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		defer f.Close()
+
+		fi, err := f.Stat()
+		if err != nil {
+			return err
+		}
+
+		hash, err := helpers.MD5FromReader(f)
+		if err != nil {
+			return err
+		}
+
+		pf := sources[filename]
+		pf.Size = fi.Size()
+		pf.MD5 = hash
+		m.Files[filename] = pf
+
+		return nil
+	}
+
+	walk := func(fs afero.Fs, publishFolder string) error {
+		return afero.Walk(fs, "", func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+			if info.IsDir() {
+				return nil
+			}
+			return addFile(fs, path, publishManifestFilename(filepath.Join(publishFolder, path)))
+		})
+	}
+
+	written, ok := h.Fs.PublishDir.(publishedFilenamesProvider)
+	if !ok {
+		// We don't know what this build wrote, so include everything
+		// in the publish directory.
+		return m, walk(h.BaseFs.PublishFs, "")
+	}
+
+	// The static files may be skipped when unchanged on disk, so
+	// we add them from the source.
+	for _, sfs := range h.BaseFs.Static {
+		if err := walk(sfs.Fs, sfs.PublishFolder); err != nil {
+			return m, err
+		}
+	}
+
+	for _, path := range written.Filenames() {
+		if err := addFile(h.BaseFs.PublishFs, path, publishManifestFilename(path)); err != nil {
+			return m, err
+		}
+	}
+
+	return m, nil
+}
+
+// fingerprintPublishManifest sets the input fingerprints in m and returns the
+// content file of every page output, keyed by the manifest filename.
+func (h *HugoSites) fingerprintPublishManifest(m *PublishManifest) (map[string]PublishedFile, error) {
+	hash := md5.New()
+	writeHashValue(hash, h.Cfg.Get(""))
+	m.Config = hex.EncodeToString(hash.Sum(nil))
+
+	hash.Reset()
+	if err := hashFiles(hash, h.BaseFs.Layouts.Fs); err != nil {
+		return nil, err
+	}
+	m.Templates = hex.EncodeToString(hash.Sum(nil))
+
+	sources := make(map[string]PublishedFile)
+	var contentFiles []string
+
+	for _, s := range h.Sites {
+		s.pageMap.withEveryBundlePage(func(p *pageState) bool {
+			if p.File().IsZero() || p.source.parsed == nil {
+				return false
+			}
+			source := PublishedFile{
+				Source:    filepath.ToSlash(p.File().Path()),
+				SourceMD5: fmt.Sprintf("%x", md5.Sum(p.source.parsed.Input())),
+			}
+			contentFiles = append(contentFiles, source.Source+":"+source.SourceMD5)
+			for _, po := range p.pageOutputs {
+				if !po.render {
+					continue
+				}
+				sources[publishManifestFilename(po.targetPaths().TargetFilename)] = source
+			}
+			return false
+		})
+	}
+
+	sort.Strings(contentFiles)
+	hash.Reset()
+	for _, f := range contentFiles {
+		io.WriteString(hash, f)
+	}
+	m.Content = hex.EncodeToString(hash.Sum(nil))
+
+	return sources, nil
+}
+
+func publishManifestFilename(path string) string {
+	return strings.TrimPrefix(filepath.ToSlash(filepath.Clean(path)), "/")
+}
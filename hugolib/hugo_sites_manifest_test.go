@@ -0,0 +1,185 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/deps"
+	"github.com/gohugoio/hugo/helpers"
+	"github.com/gohugoio/hugo/hugofs"
+	"github.com/spf13/afero"
+)
+
+func TestWritePublishManifest(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+[build]
+writeManifest = true
+-- content/p1.md --
+---
+title: P1
+---
+-- layouts/_default/single.html --
+Single: {{ .Title }}
+-- layouts/_default/list.html --
+List: {{ .Title }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+		},
+	).Build()
+
+	// Left over from a previous build.
+	publishDir := b.fs.PublishDir.(hugofs.FilesystemUnwrapper).UnwrapFilesystem()
+	b.Assert(afero.WriteFile(publishDir, "old.html", []byte("Old"), 0o666), qt.IsNil)
+
+	m, err := b.H.CreatePublishManifest()
+	b.Assert(err, qt.IsNil)
+	b.Assert(b.H.WritePublishManifest(m), qt.IsNil)
+
+	m = PublishManifest{}
+	b.Assert(json.Unmarshal([]byte(b.FileContent(PublishManifestFilename)), &m), qt.IsNil)
+
+	b.Assert(m.Files, qt.HasLen, 2)
+	content := b.FileContent("public/p1/index.html")
+	b.Assert(m.Files["p1/index.html"], qt.Equals, PublishedFile{
+		Size:      int64(len(content)),
+		MD5:       helpers.MD5String(content),
+		Source:    "p1.md",
+		SourceMD5: helpers.MD5String(b.FileContent("content/p1.md")),
+	})
+	b.Assert(m.Files["index.html"].MD5, qt.Equals, helpers.MD5String(b.FileContent("public/index.html")))
+}
+
+func TestComparePublishManifest(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404", "section"]
+[build]
+writeManifest = true
+-- content/p1.md --
+---
+title: P1
+---
+P1 content.
+-- content/p2.md --
+---
+title: P2
+---
+-- layouts/_default/single.html --
+Single: {{ .Title }}|{{ .Content }}
+-- layouts/_default/list.html --
+List: {{ range .Site.RegularPages }}{{ .Title }}|{{ end }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	prev, err := b.H.CreatePublishManifest()
+	b.Assert(err, qt.IsNil)
+
+	rebuild := func() PublishManifestDiff {
+		b.Helper()
+		// The files from the previous build are left in place.
+		b.fs.PublishDir.(hugofs.Reseter).Reset()
+		b.H, err = NewHugoSites(deps.DepsCfg{Cfg: b.H.Cfg, Fs: b.fs, Logger: b.H.Log})
+		b.Assert(err, qt.IsNil)
+		b.Assert(b.H.Build(BuildCfg{}), qt.IsNil)
+		m, err := b.H.CreatePublishManifest()
+		b.Assert(err, qt.IsNil)
+		diff := m.Diff(prev)
+		prev = m
+		return diff
+	}
+
+	b.Assert(rebuild(), qt.HasLen, 0)
+
+	b.writeSource(b.absFilename("content/p1.md"), "---\ntitle: P1\n---\nP1 edited.")
+	b.Assert(rebuild(), qt.DeepEquals, PublishManifestDiff{
+		{Filename: "p1/index.html", Status: FileStatusChanged, Cause: ChangeCauseContent},
+	})
+
+	b.writeSource(b.absFilename("content/p3.md"), "---\ntitle: P3\n---\n")
+	b.Assert(b.fs.Source.Remove(b.absFilename("content/p2.md")), qt.IsNil)
+	b.Assert(rebuild(), qt.DeepEquals, PublishManifestDiff{
+		{Filename: "index.html", Status: FileStatusChanged, Cause: ChangeCauseContent},
+		{Filename: "p2/index.html", Status: FileStatusRemoved, Cause: ChangeCauseContent},
+		{Filename: "p3/index.html", Status: FileStatusAdded, Cause: ChangeCauseContent},
+	})
+
+	b.writeSource(b.absFilename("layouts/_default/single.html"), "Single edited: {{ .Title }}|{{ .Content }}")
+	diff := rebuild()
+	b.Assert(diff, qt.DeepEquals, PublishManifestDiff{
+		{Filename: "p1/index.html", Status: FileStatusChanged, Cause: ChangeCauseTemplate},
+		{Filename: "p3/index.html", Status: FileStatusChanged, Cause: ChangeCauseTemplate},
+	})
+
+	var buf bytes.Buffer
+	diff.Write(&buf)
+	b.Assert(buf.String(), qt.Equals, `
+Cause: template change (0 added, 0 removed, 2 changed)
+  changed  p1/index.html
+  changed  p3/index.html
+`)
+}
+
+func TestPublishManifestDiffConfig(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	prev := PublishManifest{
+		Config:    "a",
+		Templates: "a",
+		Content:   "a",
+		Files: map[string]PublishedFile{
+			"index.html": {Size: 1, MD5: "a"},
+			"style.css":  {Size: 1, MD5: "a"},
+		},
+	}
+
+	m := prev
+	m.Config = "b"
+	m.Files = map[string]PublishedFile{
+		"index.html": {Size: 2, MD5: "b"},
+		"style.css":  {Size: 1, MD5: "a"},
+	}
+
+	c.Assert(m.Diff(prev), qt.DeepEquals, PublishManifestDiff{
+		{Filename: "index.html", Status: FileStatusChanged, Cause: ChangeCauseConfig},
+	})
+
+	// No fingerprints in the old manifest.
+	c.Assert(m.Diff(PublishManifest{Files: prev.Files}), qt.DeepEquals, PublishManifestDiff{
+		{Filename: "index.html", Status: FileStatusChanged, Cause: ChangeCauseOther},
+	})
+}
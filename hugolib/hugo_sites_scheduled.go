@@ -0,0 +1,183 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gohugoio/hugo/common/htime"
+	"github.com/gohugoio/hugo/resources/page"
+)
+
+// ScheduledChangesFilename is the name of the file written to the project
+// root when build.writeScheduled is enabled.
+const ScheduledChangesFilename = "hugo_scheduled.json"
+
+const (
+	// ScheduledPublish is a page with a publishDate in the future.
+	ScheduledPublish = "publish"
+
+	// ScheduledExpire is a published page with an expiryDate in the future.
+	ScheduledExpire = "expire"
+)
+
+// ScheduledChange is a point in time where a new build will change the site
+// because a page gets published or expires.
+type ScheduledChange struct {
+	Date time.Time
+
+	// ScheduledPublish or ScheduledExpire.
+	Kind string
+
+	// The page. Note that a page not yet published is not part of the site,
+	// so use this to get the front matter, not to link to it.
+	Page page.Page
+}
+
+// ScheduledChanges returns the changes scheduled after the last build in all
+// sites, sorted by date. This is what a scheduler needs to know to trigger
+// the next build.
+// Drafts are not included, unless buildDrafts is set.
+func (h *HugoSites) ScheduledChanges() []ScheduledChange {
+	var changes []ScheduledChange
+	for _, s := range h.Sites {
+		changes = append(changes, s.scheduled.list()...)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		ci, cj := changes[i], changes[j]
+		if !ci.Date.Equal(cj.Date) {
+			return ci.Date.Before(cj.Date)
+		}
+		if ci.Page.Lang() != cj.Page.Lang() {
+			return ci.Page.Lang() < cj.Page.Lang()
+		}
+		return ci.Page.Pathc() < cj.Page.Pathc()
+	})
+
+	return changes
+}
+
+// scheduledChangeJSON is the JSON version of a ScheduledChange.
+type scheduledChangeJSON struct {
+	Date time.Time `json:"date"`
+	Kind string    `json:"kind"`
+	Lang string    `json:"lang"`
+
+	// The content file, relative to the project root, with Unix style slashes.
+	Path string `json:"path"`
+}
+
+func (h *HugoSites) writeScheduledChanges() error {
+	if !h.ResourceSpec.BuildConfig.WriteScheduled {
+		return nil
+	}
+
+	changes := make([]scheduledChangeJSON, 0)
+	for _, change := range h.ScheduledChanges() {
+		filename := change.Page.File().Filename()
+		if rel, err := filepath.Rel(h.WorkingDir, filename); err == nil {
+			filename = rel
+		}
+		changes = append(changes, scheduledChangeJSON{
+			Date: change.Date,
+			Kind: change.Kind,
+			Lang: change.Page.Lang(),
+			Path: filepath.ToSlash(filename),
+		})
+	}
+
+	js, err := json.MarshalIndent(changes, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return h.writeWorkingDirFile(ScheduledChangesFilename, js)
+}
+
+type scheduledChanges struct {
+	mu sync.Mutex
+
+	// Keyed by filename. A page may be reassembled on rebuilds, and
+	// its Pathc is then not always the same.
+	changes map[string]ScheduledChange
+}
+
+// add records the scheduled change for p, if any, given whether p is built now.
+func (c *scheduledChanges) add(p page.Page, build, buildDrafts bool) {
+	if p.File().IsZero() || (p.Draft() && !buildDrafts) {
+		return
+	}
+
+	now := htime.Now()
+
+	var change ScheduledChange
+
+	if build {
+		if expiry := p.ExpiryDate(); !expiry.IsZero() && expiry.After(now) {
+			change = ScheduledChange{Date: expiry, Kind: ScheduledExpire, Page: p}
+		}
+	} else if publish := p.PublishDate(); !publish.IsZero() && publish.After(now) {
+		if expiry := p.ExpiryDate(); expiry.IsZero() || expiry.After(publish) {
+			change = ScheduledChange{Date: publish, Kind: ScheduledPublish, Page: p}
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	filename := p.File().Filename()
+
+	if change.Page == nil {
+		delete(c.changes, filename)
+		return
+	}
+
+	if c.changes == nil {
+		c.changes = make(map[string]ScheduledChange)
+	}
+	c.changes[filename] = change
+}
+
+// reset removes all changes. Used before the content is processed again.
+func (c *scheduledChanges) reset() {
+	c.mu.Lock()
+	c.changes = nil
+	c.mu.Unlock()
+}
+
+// removeByFilename removes the change for the content file removed in a
+// rebuild, if any. A page not built is not in the page map, so we need
+// to do this here.
+func (c *scheduledChanges) removeByFilename(filename string) {
+	c.mu.Lock()
+	delete(c.changes, filename)
+	c.mu.Unlock()
+}
+
+func (c *scheduledChanges) list() []ScheduledChange {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	changes := make([]ScheduledChange, 0, len(c.changes))
+	for _, v := range c.changes {
+		changes = append(changes, v)
+	}
+
+	return changes
+}
@@ -0,0 +1,178 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestScheduledChanges(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+defaultContentLanguage = "en"
+[languages]
+[languages.en]
+weight = 1
+[languages.nn]
+weight = 2
+-- content/published.md --
+---
+title: "Published"
+date: 2001-01-01
+---
+-- content/expires.md --
+---
+title: "Expires"
+expiryDate: 2099-03-01
+---
+-- content/future.md --
+---
+title: "Future"
+publishDate: 2099-02-01
+expiryDate: 2099-04-01
+---
+-- content/future.nn.md --
+---
+title: "Future NN"
+publishDate: 2099-01-01T10:00:00Z
+---
+-- content/draft.md --
+---
+title: "Draft"
+publishDate: 2099-01-01
+draft: true
+---
+-- content/expired.md --
+---
+title: "Expired"
+expiryDate: 2001-01-01
+---
+-- content/never.md --
+---
+title: "Never"
+publishDate: 2099-05-01
+expiryDate: 2099-01-01
+---
+-- layouts/_default/single.html --
+{{ .Title }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	var got []string
+	for _, change := range b.H.ScheduledChanges() {
+		got = append(got, fmt.Sprintf("%s|%s|%s|%s", change.Date.UTC().Format(time.RFC3339), change.Kind, change.Page.Lang(), change.Page.Pathc()))
+	}
+
+	b.Assert(got, qt.DeepEquals, []string{
+		"2099-01-01T10:00:00Z|publish|nn|future.nn.md",
+		"2099-02-01T00:00:00Z|publish|en|future.md",
+		"2099-03-01T00:00:00Z|expire|en|expires.md",
+	})
+}
+
+func TestScheduledChangesWriteScheduled(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+[build]
+writeScheduled = true
+-- content/published.md --
+---
+title: "Published"
+date: 2001-01-01
+---
+-- content/future.md --
+---
+title: "Future"
+publishDate: 2099-02-01
+---
+-- layouts/_default/single.html --
+{{ .Title }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent(ScheduledChangesFilename, `
+"date": "2099-02-01T00:00:00Z",
+"kind": "publish",
+"lang": "en",
+"path": "content/future.md"
+`)
+	b.Assert(b.FileContent(ScheduledChangesFilename), qt.Not(qt.Contains), "published.md")
+}
+
+func TestScheduledChangesRebuild(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+-- content/future1.md --
+---
+title: "Future 1"
+publishDate: 2099-01-01
+---
+-- content/future2.md --
+---
+title: "Future 2"
+publishDate: 2099-02-01
+---
+-- layouts/_default/single.html --
+{{ .Title }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			Running:     true,
+		},
+	).Build()
+
+	paths := func() []string {
+		var paths []string
+		for _, change := range b.H.ScheduledChanges() {
+			paths = append(paths, change.Page.Pathc())
+		}
+		return paths
+	}
+
+	b.Assert(paths(), qt.DeepEquals, []string{"future1.md", "future2.md"})
+
+	b.RemoveFiles("content/future1.md").Build()
+	b.Assert(paths(), qt.DeepEquals, []string{"future2.md"})
+
+	b.EditFiles("content/future2.md", "---\ntitle: \"Future 2\"\npublishDate: 2001-02-01\n---\n").Build()
+	b.Assert(paths(), qt.IsNil)
+}
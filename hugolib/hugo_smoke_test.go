@@ -43,6 +43,54 @@ title: Page
 	b.AssertFileContent("public/index.html", `Site: EN`)
 }
 
+// A new site without any content or layouts should build.
+func TestEmptySite(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+title = "My New Site"
+enableRobotsTXT = true
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html", "<title>My New Site</title>", "<h1>My New Site</h1>")
+	b.AssertFileContent("public/robots.txt", "User-agent: *")
+	b.AssertFileContent("public/sitemap.xml", "<loc>https://example.org/</loc>")
+	b.AssertLogContains(`No content found for language "en"`)
+	b.AssertLogContains(`found no layout file for "HTML" for kind "home": Using a minimal built-in template.`)
+}
+
+// The built-in home page is only used when there are no layouts at all.
+func TestEmptySiteWithLayouts(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+title = "My New Site"
+-- layouts/_default/single.html --
+Single.
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertDestinationExists("index.html", false)
+	b.AssertLogContains(`found no layout file for "HTML" for kind "home": You should create a template file`)
+}
+
 func TestSmoke(t *testing.T) {
 	t.Parallel()
 
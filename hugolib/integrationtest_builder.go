@@ -154,6 +154,37 @@ func (s *IntegrationTestBuilder) AssertFileContentExact(filename string, matches
 	}
 }
 
+// AssertFileContentGolden asserts that the content of filename, e.g.
+// public/index.html, is the same as the content of the golden file
+// goldenFilename on disk, usually below testdata in the test's package.
+// The golden file is written when UpdateGolden is set in the config.
+func (s *IntegrationTestBuilder) AssertFileContentGolden(filename, goldenFilename string) {
+	s.Helper()
+	content := s.FileContent(filename)
+
+	if s.Cfg.UpdateGolden {
+		s.Assert(os.MkdirAll(filepath.Dir(goldenFilename), 0777), qt.IsNil)
+		s.Assert(os.WriteFile(goldenFilename, []byte(content), 0666), qt.IsNil)
+		return
+	}
+
+	golden, err := os.ReadFile(goldenFilename)
+	s.Assert(err, qt.IsNil, qt.Commentf("set UpdateGolden in the config to create it"))
+	s.Assert(content, qt.Equals, string(golden), qt.Commentf("golden file %s", goldenFilename))
+}
+
+// PageContent returns the published content of the page with the given ref,
+// e.g. "/blog/mypost", in the default output format of the first site.
+func (s *IntegrationTestBuilder) PageContent(ref string) string {
+	s.Helper()
+	p, err := s.H.Sites[0].Info.GetPage(ref)
+	s.Assert(err, qt.IsNil)
+	s.Assert(p, qt.IsNotNil, qt.Commentf("page %q not found", ref))
+	ps, ok := p.(*pageState)
+	s.Assert(ok, qt.IsTrue, qt.Commentf("page %q not found", ref))
+	return s.readFileFromFs(s, s.fs.PublishDir, ps.targetPaths().TargetFilename)
+}
+
 func (s *IntegrationTestBuilder) AssertDestinationExists(filename string, b bool) {
 	checker := qt.IsTrue
 	if !b {
@@ -319,6 +350,10 @@ func (s *IntegrationTestBuilder) initBuilder() error {
 		cfg.Set("workingDir", s.Cfg.WorkingDir)
 
 		fs := hugofs.NewFrom(afs, cfg)
+		if config.DecodeBuild(cfg).WriteManifest {
+			// As in the hugo command, see CreatePublishManifest.
+			fs.PublishDir = hugofs.NewCreateCountingFs(fs.PublishDir)
+		}
 
 		s.Assert(err, qt.IsNil)
 
@@ -487,5 +522,9 @@ type IntegrationTestConfig struct {
 	// Whether to run npm install before Build.
 	NeedsNpmInstall bool
 
+	// Whether to write the golden files in AssertFileContentGolden instead of
+	// comparing with them, e.g. set from a test flag.
+	UpdateGolden bool
+
 	WorkingDir string
 }
@@ -0,0 +1,61 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestIntegrationTestBuilderPageContentAndGolden(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	files := `
+-- config.toml --
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404", "section"]
+theme = "mytheme"
+-- content/blog/p1.md --
+---
+title: P1
+---
+Content.
+-- themes/mytheme/layouts/index.html --
+Home.
+-- themes/mytheme/layouts/_default/single.html --
+<h1>{{ .Title }}</h1>{{ .Content }}
+`
+
+	goldenFilename := filepath.Join(t.TempDir(), "golden", "p1.html")
+
+	for _, update := range []bool{true, false} {
+		b := NewIntegrationTestBuilder(
+			IntegrationTestConfig{
+				T:            c,
+				TxtarString:  files,
+				UpdateGolden: update,
+			},
+		).Build()
+
+		b.Assert(b.PageContent("/blog/p1"), qt.Equals, "<h1>P1</h1><p>Content.</p>\n")
+		b.AssertFileContentGolden("public/blog/p1/index.html", goldenFilename)
+	}
+
+	golden, err := os.ReadFile(goldenFilename)
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(golden), qt.Equals, "<h1>P1</h1><p>Content.</p>\n")
+}
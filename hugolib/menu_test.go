@@ -588,3 +588,69 @@ Page IsAncestor Self: false
 Page IsDescendant Self: false
 `)
 }
+
+func TestMenuTrail(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+disableKinds = ['RSS','sitemap','taxonomy','term']
+defaultContentLanguage = 'en'
+[languages.en]
+weight = 1
+[languages.nn]
+weight = 2
+[[languages.en.menu.main]]
+name = 'Docs'
+pageRef = '/docs'
+weight = 1
+[[languages.en.menu.main]]
+name = 'Guides'
+identifier = 'guides'
+parent = 'Docs'
+weight = 1
+[[languages.en.menu.main]]
+name = 'Install'
+pageRef = '/docs/install'
+parent = 'guides'
+weight = 1
+[[languages.nn.menu.main]]
+name = 'Installer'
+pageRef = '/docs/install'
+weight = 1
+-- content/docs/_index.md --
+---
+title: Docs
+---
+-- content/docs/install.md --
+---
+title: Install
+---
+-- content/docs/install.nn.md --
+---
+title: Installer
+---
+-- content/other.md --
+---
+title: Other
+---
+-- layouts/_default/single.html --
+{{ $trail := .MenuTrail "main" }}
+Trail: {{ range $trail }}{{ .Name }}|{{ end }}
+{{ range site.Menus.main }}{{ .Name }}: {{ in $trail . }}|{{ end }}
+Nope: {{ len (.MenuTrail "nope") }}
+-- layouts/_default/list.html --
+List.
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/docs/install/index.html", "Trail: Docs|Guides|Install|", "Docs: true|", "Nope: 0")
+	b.AssertFileContent("public/nn/docs/install/index.html", "Trail: Installer|", "Installer: true|")
+	b.AssertFileContent("public/other/index.html", "Trail: \n", "Docs: false|")
+}
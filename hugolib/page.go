@@ -273,6 +273,12 @@ func (p *pageState) Pages() page.Pages {
 			pages = p.bucket.getTaxonomyEntries()
 		case page.KindTaxonomy:
 			pages = p.bucket.getTaxonomies()
+		case kindSitemap:
+			for _, pp := range p.s.Pages() {
+				if isInSitemap(pp) {
+					pages = append(pages, pp)
+				}
+			}
 		default:
 			pages = p.s.Pages()
 		}
@@ -283,6 +289,16 @@ func (p *pageState) Pages() page.Pages {
 	return p.pages
 }
 
+// isInSitemap reports whether p should be listed in the sitemap, i.e. it's not
+// disabled in the sitemap config and its main output format is HTML.
+func isInSitemap(p page.Page) bool {
+	if p.Sitemap().Disable {
+		return false
+	}
+	ofs := p.OutputFormats()
+	return len(ofs) == 0 || ofs[0].Format.IsHTML
+}
+
 // RawContent returns the un-rendered source content without
 // any leading front matter.
 func (p *pageState) RawContent() string {
@@ -321,6 +337,18 @@ func (p *pageState) sortResources() {
 }
 
 func (p *pageState) Resources() resource.Resources {
+	if p.m.inheritResources {
+		p.inheritedResourcesInit.Do(func() {
+			p.inheritedResources = p.collectInheritedResources()
+		})
+		return p.inheritedResources
+	}
+	return p.ownResources()
+}
+
+// ownResources returns the resources bundled with this page, sorted and
+// with any resources metadata from front matter applied.
+func (p *pageState) ownResources() resource.Resources {
 	p.resourcesInit.Do(func() {
 		p.sortResources()
 		if len(p.m.resourcesMetadata) > 0 {
@@ -331,6 +359,34 @@ func (p *pageState) Resources() resource.Resources {
 	return p.resources
 }
 
+// collectInheritedResources returns this page's resources followed by the
+// resources of its ancestor branch bundles, closest first, leaving out
+// the resources with the same name as one closer to this page.
+func (p *pageState) collectInheritedResources() resource.Resources {
+	seen := make(map[string]bool)
+	var all resource.Resources
+	add := func(rs resource.Resources) {
+		for _, r := range rs {
+			if seen[r.Name()] {
+				continue
+			}
+			seen[r.Name()] = true
+			all = append(all, r)
+		}
+	}
+
+	add(p.ownResources())
+	for parent := p.Parent(); parent != nil; parent = parent.Parent() {
+		pp, ok := parent.(*pageState)
+		if !ok {
+			break
+		}
+		add(pp.ownResources())
+	}
+
+	return all
+}
+
 func (p *pageState) HasShortcode(name string) bool {
 	if p.shortcodeState == nil {
 		return false
@@ -375,6 +431,15 @@ func (p *pageState) TranslationKey() string {
 	return p.translationKey
 }
 
+// ID returns a stable identifier for this page, the MD5 hash of its language
+// and translation key, e.g. "en/page/about/index".
+func (p *pageState) ID() string {
+	p.idInit.Do(func() {
+		p.id = helpers.MD5String(path.Join(p.Language().Lang, p.TranslationKey()))
+	})
+	return p.id
+}
+
 // AllTranslations returns all translations, including the current Page.
 func (p *pageState) AllTranslations() page.Pages {
 	p.s.h.init.translations.Do()
@@ -473,7 +538,8 @@ func (p *pageState) renderResources() (err error) {
 	p.resourcesPublishInit.Do(func() {
 		var toBeDeleted []int
 
-		for i, r := range p.Resources() {
+		// Inherited resources are published by the page owning them.
+		for i, r := range p.ownResources() {
 
 			if _, ok := r.(page.Page); ok {
 				// Pages gets rendered with the owning page but we count them here.
@@ -762,7 +828,11 @@ Loop:
 		case it.IsEOF():
 			break Loop
 		case it.IsError():
-			err := fail(errors.New(it.ValStr(result.Input())), it)
+			err := it.Err
+			if err == nil {
+				err = errors.New(it.ValStr(result.Input()))
+			}
+			err = fail(err, it)
 			currShortcode.err = err
 			return err
 
@@ -132,6 +132,8 @@ type pageCommon struct {
 
 	// Calculated an cached translation mapping key
 	translationKey     string
+	idInit             sync.Once
+	id                 string
 	translationKeyInit sync.Once
 
 	// Will only be set for bundled pages.
@@ -153,4 +155,9 @@ type pagePages struct {
 	regularPages              page.Pages
 	regularPagesRecursiveInit sync.Once
 	regularPagesRecursive     page.Pages
+
+	// The bundled resources followed by those of the ancestor branch
+	// bundles, see inheritResources.
+	inheritedResourcesInit sync.Once
+	inheritedResources     resource.Resources
 }
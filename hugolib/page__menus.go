@@ -40,6 +40,11 @@ func (p *pageMenus) IsMenuCurrent(menuID string, inme *navigation.MenuEntry) boo
 	return p.q.IsMenuCurrent(menuID, inme)
 }
 
+func (p *pageMenus) MenuTrail(menuID string) navigation.Menu {
+	p.p.s.init.menus.Do()
+	return p.p.s.menuTrails.get(menuID)[p.p]
+}
+
 func (p *pageMenus) Menus() navigation.PageMenus {
 	// There is a reverse dependency here. initMenus will, once, build the
 	// site menus and update any relevant page.
@@ -68,3 +73,29 @@ func (p *pageMenus) init() {
 		}
 	})
 }
+
+// menuTrails caches the trails to the pages in a site's menus, which would
+// otherwise be searched for every page and menu entry.
+type menuTrails struct {
+	menus navigation.Menus
+
+	mu     sync.Mutex
+	trails map[string]map[navigation.Page]navigation.Menu
+}
+
+func (m *menuTrails) get(menuID string) map[navigation.Page]navigation.Menu {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if trails, found := m.trails[menuID]; found {
+		return trails
+	}
+
+	if m.trails == nil {
+		m.trails = make(map[string]map[navigation.Page]navigation.Menu)
+	}
+	trails := navigation.MenuTrails(m.menus[menuID])
+	m.trails[menuID] = trails
+
+	return trails
+}
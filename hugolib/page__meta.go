@@ -18,6 +18,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -29,6 +30,7 @@ import (
 
 	"github.com/gohugoio/hugo/hugofs/files"
 
+	"github.com/gohugoio/hugo/common/herrors"
 	"github.com/gohugoio/hugo/common/hugo"
 
 	"github.com/gohugoio/hugo/related"
@@ -68,6 +70,13 @@ type pageMeta struct {
 
 	bundleType files.ContentClass
 
+	// Whether the resources of the ancestor branch bundles are included in
+	// this page's resources.
+	inheritResources bool
+
+	// The newest OS mod time of the bundle's resources, if any.
+	resourcesModTime time.Time
+
 	// Params contains configuration defined in the params section of page frontmatter.
 	params map[string]any
 
@@ -107,6 +116,10 @@ type pageMeta struct {
 	// From front matter.
 	configuredOutputFormats output.Formats
 
+	// File name overrides for the output formats above, keyed by the
+	// lower case output format name.
+	outputFilenames map[string]string
+
 	// This is the raw front matter metadata that is going to be assigned to
 	// the Resources above.
 	resourcesMetadata []map[string]any
@@ -227,6 +240,22 @@ func (p *pageMeta) Param(key any) (any, error) {
 	return resource.Param(p, p.s.Info.Params(), key)
 }
 
+func (p *pageMeta) GetString(key any) (string, error) {
+	return resource.ParamString(p, p.s.Info.Params(), key)
+}
+
+func (p *pageMeta) GetInt(key any) (int, error) {
+	return resource.ParamInt(p, p.s.Info.Params(), key)
+}
+
+func (p *pageMeta) GetBool(key any) (bool, error) {
+	return resource.ParamBool(p, p.s.Info.Params(), key)
+}
+
+func (p *pageMeta) GetDate(key any) (time.Time, error) {
+	return resource.ParamDate(p, p.s.Info.Params(), key, langs.GetLocation(p.s.Language()))
+}
+
 func (p *pageMeta) Params() maps.Params {
 	return p.params
 }
@@ -350,13 +379,14 @@ func (pm *pageMeta) mergeBucketCascades(b1, b2 *pagesMapBucket) {
 func (pm *pageMeta) setMetadata(parentBucket *pagesMapBucket, p *pageState, frontmatter map[string]any) error {
 	pm.params = make(maps.Params)
 
-	if frontmatter == nil && (parentBucket == nil || parentBucket.cascade == nil) {
+	if frontmatter == nil && (parentBucket == nil || parentBucket.cascade == nil) && p.Kind() != page.KindPage {
 		return nil
 	}
 
 	if frontmatter != nil {
 		// Needed for case insensitive fetching of params values
 		maps.PrepareParams(frontmatter)
+		pm.validateFrontMatter(p, frontmatter)
 		if p.bucket != nil {
 			// Check for any cascade define on itself.
 			if cv, found := frontmatter["cascade"]; found {
@@ -371,6 +401,11 @@ func (pm *pageMeta) setMetadata(parentBucket *pagesMapBucket, p *pageState, fron
 		frontmatter = make(map[string]any)
 	}
 
+	// The defaults in the directory's _meta file win over any cascade.
+	if err := applyContentDirDefaults(p, frontmatter); err != nil {
+		return err
+	}
+
 	var cascade map[page.PageMatcher]maps.Params
 
 	if p.bucket != nil {
@@ -388,8 +423,18 @@ func (pm *pageMeta) setMetadata(parentBucket *pagesMapBucket, p *pageState, fron
 			continue
 		}
 		for kk, vv := range v {
-			if _, found := frontmatter[kk]; !found {
+			if fv, found := frontmatter[kk]; !found {
 				frontmatter[kk] = vv
+			} else if kk == "sitemap" {
+				// Cascade the sitemap settings not set in the page itself.
+				sitemap := make(maps.Params)
+				for sk, sv := range maps.ToStringMap(vv) {
+					sitemap[sk] = sv
+				}
+				for sk, sv := range maps.ToStringMap(fv) {
+					sitemap[sk] = sv
+				}
+				frontmatter[kk] = sitemap
 			}
 		}
 	}
@@ -409,14 +454,15 @@ func (pm *pageMeta) setMetadata(parentBucket *pagesMapBucket, p *pageState, fron
 	}
 
 	descriptor := &pagemeta.FrontMatterDescriptor{
-		Frontmatter:   frontmatter,
-		Params:        pm.params,
-		Dates:         &pm.Dates,
-		PageURLs:      &pm.urlPaths,
-		BaseFilename:  contentBaseName,
-		ModTime:       mtime,
-		GitAuthorDate: gitAuthorDate,
-		Location:      langs.GetLocation(pm.s.Language()),
+		Frontmatter:      frontmatter,
+		Params:           pm.params,
+		Dates:            &pm.Dates,
+		PageURLs:         &pm.urlPaths,
+		BaseFilename:     contentBaseName,
+		ModTime:          mtime,
+		ResourcesModTime: pm.resourcesModTime,
+		GitAuthorDate:    gitAuthorDate,
+		Location:         langs.GetLocation(pm.s.Language()),
 	}
 
 	// Handle the date separately
@@ -501,8 +547,10 @@ func (pm *pageMeta) setMetadata(parentBucket *pagesMapBucket, p *pageState, fron
 				pm.buildConfig.Render = pagemeta.Never
 			}
 		case "outputs":
-			o := cast.ToStringSlice(v)
-			if len(o) > 0 {
+			o, filenames, err := decodeOutputs(v)
+			if err != nil {
+				p.s.Log.Errorf("Failed to resolve output formats: %s", err)
+			} else if len(o) > 0 {
 				// Output formats are explicitly set in front matter, use those.
 				outFormats, err := p.s.outputFormatsConfig.GetByNames(o...)
 
@@ -510,6 +558,7 @@ func (pm *pageMeta) setMetadata(parentBucket *pagesMapBucket, p *pageState, fron
 					p.s.Log.Errorf("Failed to resolve output formats: %s", err)
 				} else {
 					pm.configuredOutputFormats = outFormats
+					pm.outputFilenames = filenames
 					pm.params[loki] = outFormats
 				}
 
@@ -539,6 +588,9 @@ func (pm *pageMeta) setMetadata(parentBucket *pagesMapBucket, p *pageState, fron
 			p.m.sitemap = config.DecodeSitemap(p.s.siteCfg.sitemap, maps.ToStringMap(v))
 			pm.params[loki] = p.m.sitemap
 			sitemapSet = true
+		case "inheritresources":
+			pm.inheritResources = cast.ToBool(v)
+			pm.params[loki] = pm.inheritResources
 		case "iscjklanguage":
 			isCJKLanguage = new(bool)
 			*isCJKLanguage = cast.ToBool(v)
@@ -649,6 +701,38 @@ func (pm *pageMeta) setMetadata(parentBucket *pagesMapBucket, p *pageState, fron
 	return nil
 }
 
+// validateFrontMatter logs a positioned warning for every well-known front matter
+// field with a value of the wrong type. All violations for the page are reported
+// in one go.
+// Note that these values have been silently ignored or coerced in earlier
+// versions, so we don't fail the build.
+func (pm *pageMeta) validateFrontMatter(p *pageState, frontmatter map[string]any) {
+	for _, v := range pm.s.frontmatterHandler.ValidateFrontMatter(frontmatter, langs.GetLocation(pm.s.Language())) {
+		if p.File().IsZero() {
+			p.s.Log.Warnf("%q: %s", p.Pathc(), v)
+			continue
+		}
+		p.s.Log.Warnln(herrors.NewFileErrorFromFile(v, p.File().Filename(), p.s.SourceSpec.Fs.Source, frontMatterKeyLineMatcher(v.Key)))
+	}
+}
+
+// frontMatterKeyLineMatcher matches the first line defining key in
+// TOML, YAML or JSON front matter.
+func frontMatterKeyLineMatcher(key string) herrors.LineMatcherFn {
+	return func(m herrors.LineMatcher) int {
+		line := strings.ToLower(m.Line)
+		trimmed := strings.TrimLeft(line, " \t\"")
+		if !strings.HasPrefix(trimmed, key) {
+			return -1
+		}
+		rest := strings.TrimLeft(trimmed[len(key):], " \t\"")
+		if rest == "" || (rest[0] != ':' && rest[0] != '=') {
+			return -1
+		}
+		return len(line) - len(trimmed) + 1
+	}
+}
+
 func (p *pageMeta) noListAlways() bool {
 	return p.buildConfig.List != pagemeta.Always
 }
@@ -790,6 +874,54 @@ func (m *pageMeta) outputFormats() output.Formats {
 	return m.s.outputFormats[m.Kind()]
 }
 
+// decodeOutputs decodes the outputs front matter, a list of output format
+// names where an entry can also map an output format name to the file name
+// to use for that format, e.g. { json = "manifest.webmanifest" }.
+// The file names are keyed by the lower case output format name.
+func decodeOutputs(v any) ([]string, map[string]string, error) {
+	entries, ok := v.([]any)
+	if !ok {
+		return cast.ToStringSlice(v), nil, nil
+	}
+
+	var (
+		names     []string
+		filenames map[string]string
+	)
+
+	for _, entry := range entries {
+		if name, ok := entry.(string); ok {
+			names = append(names, name)
+			continue
+		}
+
+		m, err := maps.ToStringMapStringE(entry)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid outputs entry %v: %w", entry, err)
+		}
+
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, name := range keys {
+			filename := m[name]
+			if filename == "" || filename == "." || filename == ".." || strings.ContainsAny(filename, "/\\") {
+				return nil, nil, fmt.Errorf("invalid file name %q for output format %q: must be a plain file name", filename, name)
+			}
+			if filenames == nil {
+				filenames = make(map[string]string)
+			}
+			names = append(names, name)
+			filenames[strings.ToLower(name)] = filename
+		}
+	}
+
+	return names, filenames, nil
+}
+
 func (p *pageMeta) Slug() string {
 	return p.urlPaths.Slug
 }
@@ -14,10 +14,13 @@
 package hugolib
 
 import (
+	"fmt"
 	"net/url"
+	"path/filepath"
 	"strings"
 
 	"github.com/gohugoio/hugo/helpers"
+	"github.com/gohugoio/hugo/resources"
 
 	"github.com/gohugoio/hugo/resources/page"
 )
@@ -43,11 +46,26 @@ func newPagePaths(
 	pageOutputFormats := make(page.OutputFormats, len(outputFormats))
 	targets := make(map[string]targetPathsHolder)
 
+	// Used to detect output formats with file names set in front matter
+	// that collide with other output formats of this page.
+	var targetFilenames map[string]string
+	if pm.outputFilenames != nil {
+		targetFilenames = make(map[string]string)
+	}
+
 	for i, f := range outputFormats {
 		desc := targetPathDescriptor
 		desc.Type = f
+		desc.Filename = pm.outputFilenames[strings.ToLower(f.Name)]
 		paths := page.CreateTargetPaths(desc)
 
+		if targetFilenames != nil {
+			if other, found := targetFilenames[paths.TargetFilename]; found {
+				return pagePaths{}, fmt.Errorf("output formats %q and %q have the same target path %q", other, f.Name, filepath.ToSlash(paths.TargetFilename))
+			}
+			targetFilenames[paths.TargetFilename] = f.Name
+		}
+
 		var relPermalink, permalink string
 
 		// If a page is headless or bundled in another,
@@ -88,6 +106,83 @@ func newPagePaths(
 	}, nil
 }
 
+// checkOutputFilenames checks that the file names set for output formats in
+// front matter don't collide with the target paths of other pages or
+// page resources. Other collisions are reported as path warnings, see
+// logPathWarnings. The pages must be prepared for rendering, so the
+// target paths of their resources are resolved.
+func (h *HugoSites) checkOutputFilenames() error {
+	var hasFilenames bool
+	for _, s := range h.Sites {
+		s.pageMap.withEveryBundlePage(func(p *pageState) bool {
+			if p.m.outputFilenames != nil {
+				hasFilenames = true
+			}
+			return hasFilenames
+		})
+		if hasFilenames {
+			break
+		}
+	}
+	if !hasFilenames {
+		return nil
+	}
+
+	type target struct {
+		owner           string
+		fromFrontMatter bool
+	}
+	targets := make(map[string]target)
+
+	add := func(filename, owner string, fromFrontMatter bool) error {
+		filename = publishManifestFilename(filename)
+		if other, found := targets[filename]; found {
+			if other.owner != owner && (fromFrontMatter || other.fromFrontMatter) {
+				return fmt.Errorf("%s and %s have the same target path %q", other.owner, owner, "/"+filename)
+			}
+			return nil
+		}
+		targets[filename] = target{owner: owner, fromFrontMatter: fromFrontMatter}
+		return nil
+	}
+
+	for _, s := range h.Sites {
+		var err error
+		s.pageMap.withEveryBundlePage(func(p *pageState) bool {
+			if err != nil {
+				return true
+			}
+			seen := make(map[*pageOutput]bool)
+			for _, po := range p.pageOutputs {
+				if !po.render || seen[po] {
+					continue
+				}
+				seen[po] = true
+				f := po.f
+				_, fromFrontMatter := p.m.outputFilenames[strings.ToLower(f.Name)]
+				owner := fmt.Sprintf("output format %q of %q", f.Name, p.pathOrTitle())
+				if err = add(po.targetPaths().TargetFilename, owner, fromFrontMatter); err != nil {
+					return true
+				}
+			}
+			for _, r := range p.ownResources() {
+				owner := fmt.Sprintf("resource %q of %q", r.Name(), p.pathOrTitle())
+				for _, filename := range resources.TargetPaths(r) {
+					if err = add(filename, owner, false); err != nil {
+						return true
+					}
+				}
+			}
+			return false
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type pagePaths struct {
 	outputFormats     page.OutputFormats
 	firstOutputFormat page.OutputFormat
@@ -25,6 +25,8 @@ import (
 
 	"errors"
 
+	"github.com/gohugoio/hugo/common/constants"
+	"github.com/gohugoio/hugo/common/loggers"
 	"github.com/gohugoio/hugo/common/text"
 	"github.com/gohugoio/hugo/common/types/hstring"
 	"github.com/gohugoio/hugo/identity"
@@ -49,6 +51,9 @@ import (
 )
 
 var (
+	// The placeholder Goldmark renders raw HTML as when not in unsafe mode.
+	rawHTMLOmitted = []byte("<!-- raw HTML omitted -->")
+
 	nopTargetPath    = targetPathsHolder{}
 	nopPagePerOutput = struct {
 		resource.ResourceLinksProvider
@@ -130,6 +135,11 @@ func newPageContentOutput(p *pageState, po *pageOutput) (*pageContentOutput, err
 
 			cp.workContent = r.Bytes()
 
+			level := p.s.ErrorLevels.Level(constants.ErrClassRawHTMLOmitted, loggers.ErrorLevelIgnore)
+			if level != loggers.ErrorLevelIgnore && bytes.Contains(cp.workContent, rawHTMLOmitted) {
+				level.Logger(p.s.Log).Printf("Raw HTML omitted in %q; set markup.goldmark.renderer.unsafe to true to render it.", p.pathOrTitle())
+			}
+
 			if tocProvider, ok := r.(converter.TableOfContentsProvider); ok {
 				cfg := p.s.ContentSpec.Converters.GetMarkupConfig()
 				cp.tableOfContents = template.HTML(
@@ -272,6 +282,7 @@ func (p *pageContentOutput) Reset() {
 	p.initMain.Reset()
 	p.initPlain.Reset()
 	p.renderHooks = &renderHooks{}
+	p.p.s.h.contentIncludes.reset(p.p)
 }
 
 func (p *pageContentOutput) Content() (any, error) {
@@ -541,6 +552,10 @@ func (p *pageContentOutput) initRenderHooks() error {
 				layoutDescriptor.Kind = "render-image"
 			case hooks.HeadingRendererType:
 				layoutDescriptor.Kind = "render-heading"
+			case hooks.BlockquoteRendererType:
+				layoutDescriptor.Kind = "render-blockquote"
+			case hooks.TableRendererType:
+				layoutDescriptor.Kind = "render-table"
 			case hooks.CodeBlockRendererType:
 				layoutDescriptor.Kind = "render-codeblock"
 				if id != nil {
@@ -178,6 +178,24 @@ func (pt pageTree) Parent() page.Page {
 	return b.p
 }
 
+func (pt pageTree) Ancestors() page.Pages {
+	var ancestors page.Pages
+	for parent := pt.Parent(); !types.IsNil(parent); parent = parent.Parent() {
+		ancestors = append(ancestors, parent)
+	}
+
+	// Home first.
+	for i, j := 0, len(ancestors)-1; i < j; i, j = i+1, j-1 {
+		ancestors[i], ancestors[j] = ancestors[j], ancestors[i]
+	}
+
+	return ancestors
+}
+
+func (pt pageTree) Breadcrumbs() page.Pages {
+	return append(pt.Ancestors(), pt.p)
+}
+
 func (pt pageTree) Sections() page.Pages {
 	if pt.p.bucket == nil {
 		return nil
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"html/template"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	qt "github.com/frankban/quicktest"
@@ -147,3 +148,43 @@ Some content.
 	b.AssertFileContent("public/myblog/p2/index.html", "Single: A page|Hello|en|RelPermalink: /myblog/p2/|Permalink: https://example.com/myblog/p2/|")
 	b.AssertFileContent("public/myblog/p3/index.html", "Single: A page|Hello|en|RelPermalink: /myblog/p3/|Permalink: https://example.com/myblog/p3/|")
 }
+
+func TestPermalinkParam(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+disableKinds = ["taxonomy", "term"]
+[permalinks]
+products = "/:param(category)/:slug/"
+-- content/products/p1.md --
+---
+title: "P1"
+slug: "p1"
+category: "Running Shoes"
+---
+-- layouts/_default/single.html --
+{{ .Title }}|{{ .RelPermalink }}|
+-- layouts/_default/list.html --
+List.
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/running-shoes/p1/index.html", "P1|/running-shoes/p1/|")
+
+	b, err := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.Replace(files, `category: "Running Shoes"`, "", 1),
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `error expanding "/:param(category)/:slug/": missing param "category"`)
+}
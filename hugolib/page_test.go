@@ -33,6 +33,7 @@ import (
 	"github.com/gohugoio/hugo/common/htime"
 	"github.com/gohugoio/hugo/common/loggers"
 
+	"github.com/gohugoio/hugo/helpers"
 	"github.com/gohugoio/hugo/hugofs"
 
 	"github.com/gohugoio/hugo/resources/page"
@@ -1117,6 +1118,41 @@ Content
 	}
 }
 
+func TestPageLastmodFromBundleResources(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	b := newTestSitesBuilder(t)
+	b.WithConfigFile("toml", `
+baseURL = "https://example.org"
+[frontmatter]
+lastmod = [":bundleModTime"]
+`)
+	b.WithContent(
+		"bundle/index.md", "---\ntitle: Bundle\n---",
+		"bundle/data.json", "{}",
+		"single.md", "---\ntitle: Single\n---",
+	)
+	b.WithTemplates("_default/single.html", "Lastmod: {{ .Lastmod.Format \"2006-01-02\" }}")
+
+	b.CreateSites()
+
+	chtime := func(filename, date string) {
+		d, err := time.Parse("2006-01-02", date)
+		c.Assert(err, qt.IsNil)
+		c.Assert(b.Fs.Source.Chtimes(filepath.Join("content", filename), d, d), qt.IsNil)
+	}
+
+	chtime("bundle/index.md", "2020-01-01")
+	chtime("bundle/data.json", "2021-06-15")
+	chtime("single.md", "2020-03-01")
+
+	b.Build(BuildCfg{})
+
+	b.AssertFileContent("public/bundle/index.html", "Lastmod: 2021-06-15")
+	b.AssertFileContent("public/single/index.html", "Lastmod: 2020-03-01")
+}
+
 func TestWordCountWithAllCJKRunesWithoutHasCJKLanguage(t *testing.T) {
 	t.Parallel()
 	assertFunc := func(t *testing.T, ext string, pages page.Pages) {
@@ -1262,6 +1298,53 @@ func TestTranslationKey(t *testing.T) {
 	c.Assert(p2.TranslationKey(), qt.Equals, "page/sect/simple")
 }
 
+func TestPageID(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap"]
+defaultContentLanguage = "en"
+[languages]
+[languages.en]
+weight = 1
+[languages.nn]
+weight = 2
+-- content/sect/p1.md --
+---
+title: "P1"
+slug: "%s"
+---
+-- content/sect/p1.nn.md --
+---
+title: "P1 nn"
+---
+-- layouts/_default/single.html --
+{{ .ID }}
+-- layouts/_default/list.html --
+{{ .ID }}
+`
+
+	build := func(slug string) *IntegrationTestBuilder {
+		return NewIntegrationTestBuilder(
+			IntegrationTestConfig{
+				T:           t,
+				TxtarString: fmt.Sprintf(files, slug),
+			},
+		).Build()
+	}
+
+	b := build("p1")
+	b.AssertFileContent("public/sect/p1/index.html", helpers.MD5String("en/page/sect/p1"))
+	b.AssertFileContent("public/nn/sect/p1/index.html", helpers.MD5String("nn/page/sect/p1"))
+	b.AssertFileContent("public/index.html", helpers.MD5String("en/home"))
+
+	// The ID survives URL changes.
+	b = build("p1-new")
+	b.AssertFileContent("public/sect/p1-new/index.html", helpers.MD5String("en/page/sect/p1"))
+}
+
 func TestChompBOM(t *testing.T) {
 	t.Parallel()
 	c := qt.New(t)
@@ -1914,6 +1997,58 @@ author = "Jo Nesbø"
 		"Author site config:  Kurt Vonnegut")
 }
 
+func TestPageParamTyped(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+timeZone = "Europe/Oslo"
+[params]
+weight = "42"
+featured = "true"
+-- content/p1.md --
+---
+title: "P1"
+count: "7"
+ratio: 2.5
+draft: false
+published: 2022-08-01
+reviewed: "2022-08-02T10:00:00"
+tags: ["a", "b"]
+---
+-- layouts/_default/single.html --
+String: {{ .GetString "count" }}|{{ .GetString "ratio" }}|{{ .GetString "missing" }}|
+Int: {{ .GetInt "count" }}|{{ .GetInt "weight" }}|{{ .GetInt "missing" }}|
+Bool: {{ .GetBool "featured" }}|{{ .GetBool "missing" }}|
+Date: {{ (.GetDate "published").Format "2006-01-02" }}|{{ (.GetDate "reviewed").Format "2006-01-02T15:04 MST" }}|{{ (.GetDate "missing").IsZero }}|
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		"String: 7|2.5||",
+		"Int: 7|42|0|",
+		"Bool: true|false|",
+		"Date: 2022-08-01|2022-08-02T10:00 CEST|true|",
+	)
+
+	b, err := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.Replace(files, `{{ .GetInt "count" }}`, `{{ .GetInt "tags" }}`, 1),
+		},
+	).BuildE()
+
+	b.Assert(err, qt.Not(qt.IsNil))
+	b.Assert(err.Error(), qt.Contains, `failed to convert param "tags" to int`)
+}
+
 func TestGoldmark(t *testing.T) {
 	t.Parallel()
 
@@ -2001,3 +2136,38 @@ Page1: {{ $p1.Path }}
 
 	b.AssertFileContent("public/index.html", "Lang: no", filepath.FromSlash("Page1: a/B/C/Page1.md"))
 }
+
+func TestPageFrontMatterValidation(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+-- content/p1.md --
+---
+title: "P1"
+weight: "heavy"
+aliases:
+  - /a/
+  - 42
+---
+-- content/p2.md --
++++
+title = "P2"
+date = "not a date"
++++
+-- layouts/_default/single.html --
+{{ .Title }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertLogMatches(`p1.md:3:1": front matter field "weight": expected a number`)
+	b.AssertLogMatches(`p1.md:4:1": front matter field "aliases": expected a list of strings, got int at index 1`)
+	b.AssertLogMatches(`p2.md:3:1": front matter field "date": unable to parse not a date as a date`)
+}
@@ -1346,3 +1346,264 @@ func TestPageBundlerHome(t *testing.T) {
 Title: Home|First Resource: data.json|Content: <p>Hook Len Page Resources 1</p>
 `)
 }
+
+func TestBundleContentFilesConfig(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+disableKinds = ["taxonomy", "term"]
+[contentFiles]
+warnSize = 20
+includes = ["**.png"]
+-- content/p1/index.md --
+---
+title: "P1"
+---
+-- content/p1/data.txt --
+Data.
+-- content/p1/images/large.png --
+iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYPhfDwAChwGA60e6kgAAAABJRU5ErkJggg==
+-- content/p2.md --
+---
+title: "P2"
+---
+-- layouts/_default/single.html --
+{{ .Title }}|{{ range .Resources }}{{ .Name }}|{{ end }}
+-- layouts/_default/list.html --
+{{ range .RegularPages }}{{ .File.Path }}|{{ end }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html", "P1|images/large.png|")
+	b.AssertFileContent("public/index.html", "p1/index.md|p2.md|")
+	b.AssertLogContains("large.png\" is 70 bytes, larger than the configured contentFiles.warnSize (20 bytes)")
+}
+
+func TestBundleBinaryContentFile(t *testing.T) {
+	t.Parallel()
+
+	files := "-- config.toml --\ndisableKinds = [\"taxonomy\", \"term\"]\n-- content/p1/index.md --\n---\ntitle: \"P1\"\n---\n-- content/p1/video.md --\nvideo\x00data\n-- content/audio.md --\naudio\x00data\n-- layouts/_default/single.html --\n{{ .Title }}|{{ range .Resources }}{{ .Name }}:{{ .ResourceType }}|{{ end }}\n-- layouts/_default/list.html --\nList.\n"
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html", "P1|video.md:")
+	b.AssertLogContains("video.md\" contains binary data")
+	b.AssertFileContent("public/audio.md", "audio\x00data")
+	b.AssertDestinationExists("audio/index.html", false)
+}
+
+func TestBundleContentFilesStatic(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+disableKinds = ["taxonomy", "term"]
+[contentFiles]
+static = true
+-- content/posts/_index.md --
+---
+title: "Posts"
+---
+-- content/posts/data.txt --
+Data.
+-- content/posts/p1.md --
+---
+title: "P1"
+---
+-- content/posts/b1/index.md --
+---
+title: "B1"
+---
+-- content/posts/b1/b1.txt --
+B1 Data.
+-- layouts/_default/single.html --
+{{ .Title }}|{{ range .Resources }}{{ .Name }}|{{ end }}
+-- layouts/_default/list.html --
+{{ .Title }}|Resources: {{ len .Resources }}|
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/posts/index.html", "Posts|Resources: 0|")
+	b.AssertFileContent("public/posts/data.txt", "Data.")
+	b.AssertFileContent("public/posts/b1/index.html", "B1|b1.txt|")
+	b.AssertFileContent("public/posts/b1/b1.txt", "B1 Data.")
+}
+
+func TestPageBundlerInheritResources(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+-- content/_index.md --
+---
+title: "Home"
+---
+-- content/footer.txt --
+Home footer.
+-- content/logo.txt --
+Home logo.
+-- content/docs/_index.md --
+---
+title: "Docs"
+cascade:
+  inheritResources: true
+---
+-- content/docs/banner.txt --
+Docs banner.
+-- content/docs/logo.txt --
+Docs logo.
+-- content/docs/p1.md --
+---
+title: "P1"
+---
+-- content/docs/leaf/index.md --
+---
+title: "Leaf"
+---
+-- content/docs/leaf/banner.txt --
+Leaf banner.
+-- content/blog/_index.md --
+---
+title: "Blog"
+---
+-- content/blog/banner.txt --
+Blog banner.
+-- content/blog/p2.md --
+---
+title: "P2"
+---
+-- layouts/index.html --
+Home.
+-- layouts/_default/list.html --
+{{ .Title }}|{{ range .Resources }}{{ .Content | chomp }}|{{ end }}
+-- layouts/_default/single.html --
+{{ .Title }}|{{ with .Resources.GetMatch "banner*" }}{{ .Content | chomp }}{{ end }}|{{ range .Resources }}{{ .Content | chomp }}|{{ end }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/docs/p1/index.html", "P1|Docs banner.|Docs banner.|Docs logo.|Home footer.|\n")
+	b.AssertFileContent("public/docs/leaf/index.html", "Leaf|Leaf banner.|Leaf banner.|Docs logo.|Home footer.|\n")
+	b.AssertFileContent("public/docs/index.html", "Docs|Docs banner.|Docs logo.|Home footer.|\n")
+	b.AssertFileContent("public/blog/p2/index.html", "P2||")
+}
+
+func TestPageBundlerInheritResourcesPublish(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+-- content/docs/_index.md --
+---
+title: "Docs"
+cascade:
+  inheritResources: true
+---
+-- content/docs/banner.txt --
+Docs banner.
+-- content/docs/leaf/index.md --
+---
+title: "Leaf"
+---
+-- content/docs/leaf/photo.txt --
+Leaf photo.
+-- layouts/index.html --
+Home.
+-- layouts/_default/list.html --
+{{ .Title }}|{{ range .Resources }}{{ .Name }}|{{ end }}
+-- layouts/_default/single.html --
+{{ .Title }}|{{ range .Resources }}{{ .Name }}|{{ end }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/docs/leaf/index.html", "Leaf|photo.txt|banner.txt|\n")
+	b.AssertFileContent("public/docs/index.html", "Docs|banner.txt|\n")
+
+	// The inherited resources are published by the page owning them only.
+	b.AssertFileContent("public/docs/banner.txt", "Docs banner.")
+	b.AssertFileContent("public/docs/leaf/photo.txt", "Leaf photo.")
+	b.AssertDestinationExists("docs/leaf/banner.txt", false)
+	b.Assert(b.H.Sites[0].PathSpec.ProcessingStats.Files, qt.Equals, uint64(2))
+}
+
+func TestPageBundlerInheritResourcesRebuild(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+-- content/docs/_index.md --
+---
+title: "Docs"
+cascade:
+  inheritResources: true
+---
+-- content/docs/banner.txt --
+Docs banner.
+-- content/docs/leaf/index.md --
+---
+title: "Leaf"
+---
+-- content/docs/leaf/photo.txt --
+Leaf photo.
+-- layouts/index.html --
+Home.
+-- layouts/_default/list.html --
+{{ .Title }}|{{ range .Resources }}{{ .Name }}|{{ end }}
+-- layouts/_default/single.html --
+{{ .Title }}|{{ range .Resources }}{{ .Name }}|{{ end }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			Running:     true,
+		},
+	).Build()
+
+	b.AssertFileContent("public/docs/leaf/index.html", "Leaf|photo.txt|banner.txt|\n")
+
+	b.AddFiles("content/docs/logo.txt", "Docs logo.").Build()
+
+	b.AssertFileContent("public/docs/index.html", "Docs|banner.txt|logo.txt|\n")
+	b.AssertFileContent("public/docs/leaf/index.html", "Leaf|photo.txt|banner.txt|logo.txt|\n")
+
+	b.RemoveFiles("content/docs/banner.txt").Build()
+
+	b.AssertFileContent("public/docs/leaf/index.html", "Leaf|photo.txt|logo.txt|\n")
+}
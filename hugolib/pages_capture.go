@@ -21,7 +21,10 @@ import (
 	"path/filepath"
 	"reflect"
 
+	"github.com/gobwas/glob"
 	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/config"
+	hglob "github.com/gohugoio/hugo/hugofs/glob"
 
 	"github.com/gohugoio/hugo/parser/pageparser"
 
@@ -92,6 +95,52 @@ type pagesCollector struct {
 	tracker *contentChangeMap
 
 	proc pagesCollectorProcessorProvider
+
+	contentFiles config.ContentFiles
+	includes     []glob.Glob
+}
+
+func (c *pagesCollector) initContentFiles() error {
+	var err error
+	c.contentFiles, err = config.DecodeContentFiles(c.sp.Cfg)
+	if err != nil {
+		return err
+	}
+	for _, pattern := range c.contentFiles.Includes {
+		g, err := hglob.GetGlob(hglob.NormalizePath(pattern))
+		if err != nil {
+			return fmt.Errorf("failed to compile contentFiles include pattern %q: %w", pattern, err)
+		}
+		c.includes = append(c.includes, g)
+	}
+	return nil
+}
+
+// includeFile reports whether the non-content file fim should be collected as
+// a page resource.
+func (c *pagesCollector) includeFile(fim hugofs.FileMetaInfo) bool {
+	if fim.IsDir() || fim.Meta().Classifier != files.ContentClassFile {
+		return true
+	}
+	if len(c.includes) == 0 {
+		return true
+	}
+	p := hglob.NormalizePath(fim.Meta().Path)
+	for _, g := range c.includes {
+		if g.Match(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkFile warns about suspiciously large files.
+// Content files with binary data are detected when the page is created,
+// see errBinaryContent.
+func (c *pagesCollector) checkFile(fim hugofs.FileMetaInfo) {
+	if c.contentFiles.WarnSize > 0 && fim.Size() > c.contentFiles.WarnSize {
+		c.logger.Warnf("File %q is %d bytes, larger than the configured contentFiles.warnSize (%d bytes). Consider moving it out of the content directory or add it to ignoreFiles.", fim.Meta().Filename, fim.Size(), c.contentFiles.WarnSize)
+	}
 }
 
 // isCascadingEdit returns whether the dir represents a cascading edit.
@@ -159,6 +208,10 @@ func (c *pagesCollector) isCascadingEdit(dir contentDirKey) (bool, string) {
 
 // Collect.
 func (c *pagesCollector) Collect() (collectErr error) {
+	if err := c.initContentFiles(); err != nil {
+		return err
+	}
+
 	c.proc.Start(context.Background())
 	defer func() {
 		err := c.proc.Wait()
@@ -196,6 +249,12 @@ func (c *pagesCollector) Collect() (collectErr error) {
 				}
 				collectErr = c.collectDir(dir.dirname, !isCascading, nil)
 			default:
+				if isContentDirDefaultsFile(dir.filename) {
+					// The defaults for all the pages in the directory may have changed.
+					c.contentMap.pmaps[0].s.h.contentDirDefaults.reset(dir.filename)
+					collectErr = c.collectDir(dir.dirname, true, nil)
+					break
+				}
 				// We always start from a directory.
 				collectErr = c.collectDir(dir.dirname, true, func(fim hugofs.FileMetaInfo) bool {
 					return dir.filename == fim.Meta().Filename
@@ -364,6 +423,10 @@ func (c *pagesCollector) collectDir(dirname string, partial bool, inFilter func(
 			return false
 		}
 
+		if !c.includeFile(fim) {
+			return false
+		}
+
 		if inFilter != nil {
 			return inFilter(fim)
 		}
@@ -398,6 +461,8 @@ func (c *pagesCollector) collectDir(dirname string, partial bool, inFilter func(
 				continue
 			}
 
+			c.checkFile(fi)
+
 			meta := fi.Meta()
 			meta.IsRootFile = walkRoot
 			class := meta.Classifier
@@ -441,6 +506,18 @@ func (c *pagesCollector) collectDir(dirname string, partial bool, inFilter func(
 			}
 		}
 
+		if btype != bundleLeaf {
+			// Front matter defaults for the pages in this directory, see
+			// contentDirDefaults. In a leaf bundle it's a regular resource.
+			filtered := readdir[:0]
+			for _, fi := range readdir {
+				if fi.IsDir() || !isContentDirDefaultsFile(fi.Name()) {
+					filtered = append(filtered, fi)
+				}
+			}
+			readdir = filtered
+		}
+
 		err := handleDir(btype, dir, path, readdir)
 		if err != nil {
 			return nil, err
@@ -506,8 +583,11 @@ func (c *pagesCollector) handleBundleBranch(readdir []hugofs.FileMetaInfo) error
 
 		meta := fim.Meta()
 
-		switch meta.Classifier {
-		case files.ContentClassContent:
+		switch {
+		case meta.Classifier == files.ContentClassContent:
+			contentFiles = append(contentFiles, fim)
+		case meta.Classifier == files.ContentClassFile && c.contentFiles.Static:
+			// Published as a static file.
 			contentFiles = append(contentFiles, fim)
 		default:
 			if err := c.addToBundle(fim, bundleBranch, bundles); err != nil {
@@ -533,10 +613,15 @@ func (c *pagesCollector) handleBundleLeaf(dir hugofs.FileMetaInfo, path string,
 		if err != nil {
 			return err
 		}
-		if info.IsDir() {
+		if info.IsDir() || !c.includeFile(info) {
 			return nil
 		}
 
+		if filepath.Dir(info.Meta().Filename) != dir.Meta().Filename {
+			// Files in the bundle root are already checked.
+			c.checkFile(info)
+		}
+
 		return c.addToBundle(info, bundleLeaf, bundles)
 	}
 
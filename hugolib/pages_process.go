@@ -32,10 +32,9 @@ func newPagesProcessor(h *HugoSites, sp *source.SourceSpec) *pagesProcessor {
 	procs := make(map[string]pagesCollectorProcessorProvider)
 	for _, s := range h.Sites {
 		procs[s.Lang()] = &sitePagesProcessor{
-			m:                  s.pageMap,
-			errorSender:        s.h,
-			itemChan:           make(chan interface{}, config.GetNumWorkerMultiplier()*2),
-			renderStaticToDisk: h.Cfg.GetBool("renderStaticToDisk"),
+			m:           s.pageMap,
+			errorSender: s.h,
+			itemChan:    make(chan interface{}, config.GetNumWorkerMultiplier()*2),
 		}
 	}
 	return &pagesProcessor{
@@ -118,8 +117,6 @@ type sitePagesProcessor struct {
 	ctx       context.Context
 	itemChan  chan any
 	itemGroup *errgroup.Group
-
-	renderStaticToDisk bool
 }
 
 func (p *sitePagesProcessor) Process(item any) error {
@@ -152,20 +149,23 @@ func (p *sitePagesProcessor) Wait() error {
 }
 
 func (p *sitePagesProcessor) copyFile(fim hugofs.FileMetaInfo) error {
+	return p.m.s.copyContentFile(fim)
+}
+
+// copyContentFile publishes the file in the content directory as is.
+func (s *Site) copyContentFile(fim hugofs.FileMetaInfo) error {
 	meta := fim.Meta()
 	f, err := meta.Open()
 	if err != nil {
 		return fmt.Errorf("copyFile: failed to open: %w", err)
 	}
 
-	s := p.m.s
-
 	target := filepath.Join(s.PathSpec.GetTargetLanguageBasePath(), meta.Path)
 
 	defer f.Close()
 
 	fs := s.PublishFs
-	if p.renderStaticToDisk {
+	if s.Cfg.GetBool("renderStaticToDisk") {
 		fs = s.PublishFsStatic
 	}
 
@@ -17,3 +17,9 @@ package hugolib
 func (h *HugoSites) GC() (int, error) {
 	return h.Deps.FileCaches.Prune()
 }
+
+// GCDryRun returns the cache files GC would remove, keyed by cache name.
+// Like GC, it requires a build first and must run on it's own.
+func (h *HugoSites) GCDryRun() (map[string][]string, error) {
+	return h.Deps.FileCaches.PruneDryRun()
+}
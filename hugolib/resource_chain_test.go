@@ -23,6 +23,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -754,3 +755,55 @@ XML: {{ $xml.Content | safeHTML }}|{{ $xml.RelPermalink }}
 XML: <root>   <foo> asdfasdf </foo> </root>|/xml/data.min.3be4fddd19aaebb18c48dd6645215b822df74701957d6d36e59f203f9c30fd9f.xml
 `)
 }
+
+func TestGetRemoteRetryAndAllowStatusCodes(t *testing.T) {
+	t.Parallel()
+
+	var flakyRequests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		switch r.URL.Path {
+		case "/flaky.txt":
+			if atomic.AddInt32(&flakyRequests, 1) == 1 {
+				http.Error(w, "unavailable", http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte("Flaky"))
+		case "/gone.txt":
+			w.WriteHeader(http.StatusGone)
+			w.Write([]byte("Gone"))
+		default:
+			http.Error(w, "failed", http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(func() {
+		ts.Close()
+	})
+
+	files := fmt.Sprintf(`
+-- config.toml --
+disableKinds = ["page", "section", "taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+[httpCache]
+allowStatusCodes = [410]
+[httpCache.retry]
+attempts = 2
+initialBackoff = "1ms"
+-- layouts/index.html --
+{{ $flaky := resources.GetRemote "%[1]s/flaky.txt" }}
+{{ $gone := resources.GetRemote "%[1]s/gone.txt" }}
+{{ $broken := resources.GetRemote "%[1]s/broken.txt" }}
+Flaky: {{ $flaky.Content }}|
+Gone: {{ $gone.Content }}|
+Broken: {{ with $broken.Err }}{{ .Data.StatusCode }}{{ end }}|
+`, ts.URL)
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html", "Flaky: Flaky|", "Gone: Gone|", "Broken: 500|")
+	b.Assert(atomic.LoadInt32(&flakyRequests), qt.Equals, int32(2))
+}
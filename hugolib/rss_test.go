@@ -14,10 +14,13 @@
 package hugolib
 
 import (
+	"encoding/xml"
+	"io"
 	"path/filepath"
 	"strings"
 	"testing"
 
+	qt "github.com/frankban/quicktest"
 	"github.com/gohugoio/hugo/deps"
 )
 
@@ -98,3 +101,149 @@ Figure:
 
 	b.AssertFileContent("public/index.xml", "img src=&#34;http://example.com/images/sunset.jpg")
 }
+
+func TestRSSPodcast(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term"]
+-- content/podcast/_index.md --
+---
+title: My Podcast
+podcast:
+  author: Jane Doe
+  category: Technology
+  image: images/cover.jpg
+  explicit: false
+  owner:
+    name: Jane Doe
+    email: jane@example.org
+---
+-- content/podcast/ep1/index.md --
+---
+title: Episode 1
+date: 2022-01-01
+podcast:
+  duration: "00:32:10"
+  episode: 1
+  explicit: true
+---
+-- content/podcast/ep1/audio.mp3 --
+ID3abcdefghij
+-- content/podcast/ep2.md --
+---
+title: Episode 2
+date: 2022-02-01
+enclosure:
+  url: https://cdn.example.org/ep2.mp3
+  length: 12345
+  type: audio/mpeg
+---
+-- content/blog/p1/index.md --
+---
+title: Blog Post
+---
+-- layouts/_default/single.html --
+{{ .Title }}
+-- layouts/_default/list.html --
+{{ .Title }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/podcast/index.xml",
+		`xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd"`,
+		"<itunes:author>Jane Doe</itunes:author>",
+		`<itunes:image href="https://example.org/images/cover.jpg" />`,
+		`<itunes:category text="Technology" />`,
+		"<itunes:explicit>false</itunes:explicit>",
+		"<itunes:email>jane@example.org</itunes:email>",
+		`<enclosure url="https://example.org/podcast/ep1/audio.mp3" length="13" type="audio/mpeg" />`,
+		"<itunes:duration>00:32:10</itunes:duration>",
+		"<itunes:episode>1</itunes:episode>",
+		"<itunes:explicit>true</itunes:explicit>",
+		`<enclosure url="https://cdn.example.org/ep2.mp3" length="12345" type="audio/mpeg" />`,
+	)
+
+	content := b.FileContent("public/blog/index.xml")
+	b.Assert(strings.Contains(content, "itunes"), qt.IsFalse)
+	b.Assert(strings.Contains(content, "<enclosure"), qt.IsFalse)
+}
+
+func TestRSSEscaping(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+title = "Tom & Jerry's <Site>"
+copyright = "© Tom & Jerry"
+[author]
+name = "Tom & Jerry"
+email = "tom@example.org"
+-- content/blog/_index.md --
+---
+title: "Cats & <Mice>"
+podcast:
+  author: "Tom & Jerry"
+  category: "Health & <Fitness> \"Daily\""
+  image: "https://cdn.example.org/cover.jpg?a=1&b=<2>\"3\""
+---
+-- content/blog/p1.md --
+---
+title: "Q&A: <script>alert(1)</script> \"quoted\""
+date: 2022-06-01
+summary: "A & B < C"
+enclosure:
+  url: "https://cdn.example.org/p1.mp3?a=1&b=<2>\"3\""
+  length: 42
+  type: "audio/mpeg; codecs=\"mp3\" & <more>"
+podcast:
+  image: "https://cdn.example.org/p1.jpg?a=1&b=<2>"
+---
+Some *content* with & and <b>HTML</b>.
+-- layouts/_default/single.html --
+{{ .Title }}
+-- layouts/_default/list.html --
+{{ .Title }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	for _, filename := range []string{"public/index.xml", "public/blog/index.xml"} {
+		content := b.FileContent(filename)
+		d := xml.NewDecoder(strings.NewReader(content))
+		for {
+			_, err := d.Token()
+			if err == io.EOF {
+				break
+			}
+			b.Assert(err, qt.IsNil, qt.Commentf("%s:\n%s", filename, content))
+		}
+	}
+
+	b.AssertFileContent("public/blog/index.xml",
+		"<title>Cats &amp; &lt;Mice&gt; on Tom &amp; Jerry&#39;s &lt;Site&gt;</title>",
+		"<title>Q&amp;A: &lt;script&gt;alert(1)&lt;/script&gt; &#34;quoted&#34;</title>",
+		// The description is HTML, escaped once more to go into the XML.
+		"<description>A &amp;amp; B &amp;lt; C</description>",
+		"<copyright>© Tom &amp; Jerry</copyright>",
+		"<itunes:author>Tom &amp; Jerry</itunes:author>",
+		`<itunes:category text="Health &amp; &lt;Fitness&gt; &#34;Daily&#34;" />`,
+		`<itunes:image href="https://cdn.example.org/cover.jpg?a=1&amp;b=%3c2%3e%223%22" />`,
+		`<enclosure url="https://cdn.example.org/p1.mp3?a=1&amp;b=%3c2%3e%223%22" length="42" type="audio/mpeg; codecs=&#34;mp3&#34; &amp; &lt;more&gt;" />`,
+		`<itunes:image href="https://cdn.example.org/p1.jpg?a=1&amp;b=%3c2%3e" />`,
+	)
+}
@@ -202,6 +202,14 @@ type shortcode struct {
 	info   tpl.Info       // One of the output formats (arbitrary)
 	templs []tpl.Template // All output formats
 
+	// Set for shortcodes without a template when not configured to fail.
+	// These are rendered as nothing or as literal.
+	unknownMode unknownShortcodeMode
+	// Whether the unknown shortcode has a closing tag.
+	unknownIsInner bool
+	// The source of the unknown shortcode when passed through.
+	literal string
+
 	// If set, the rendered shortcode is sent as part of the surrounding content
 	// to Goldmark and similar.
 	// Before Hug0 0.55 we didn't send any shortcode output to the markup
@@ -343,6 +351,9 @@ func renderShortcode(
 				return "", false, fmt.Errorf("no earlier definition of shortcode %q found", sc.name)
 			}
 		}
+	} else if sc.unknownMode != 0 {
+		// Already logged when parsed.
+		return sc.literal, false, nil
 	} else {
 		var found, more bool
 		tmpl, found, more = s.Tmpl().LookupVariant(sc.name, tplVariants)
@@ -533,6 +544,13 @@ func (s *shortcodeHandler) extractShortcode(ordinal, level int, source []byte, p
 		return s.parseError(fmt.Errorf("%s: %w", errorPrefix, err), source, i.Pos())
 	}
 
+	// The source of the shortcode up to and including the current item.
+	start := pt.Peek().Pos()
+	literal := func() string {
+		end := pt.Current()
+		return string(source[start : end.Pos()+len(end.Val(source))])
+	}
+
 Loop:
 	for {
 		currItem := pt.Next()
@@ -571,7 +589,14 @@ Loop:
 		case currItem.IsRightShortcodeDelim():
 			// we trust the template on this:
 			// if there's no inner, we're done
-			if !sc.isInline {
+			if sc.unknownMode != 0 {
+				if !sc.unknownIsInner {
+					if sc.unknownMode == unknownShortcodePassthrough {
+						sc.literal = literal()
+					}
+					return sc, nil
+				}
+			} else if !sc.isInline {
 				if sc.info == nil {
 					// This should not happen.
 					return sc, fail(errors.New("BUG: template info not set"), currItem)
@@ -583,7 +608,7 @@ Loop:
 
 		case currItem.IsShortcodeClose():
 			next := pt.Peek()
-			if !sc.isInline {
+			if !sc.isInline && sc.unknownMode == 0 {
 				if sc.info == nil || !sc.info.ParseInfo().IsInner {
 					if next.IsError() {
 						// return that error, more specific
@@ -600,6 +625,10 @@ Loop:
 				pt.Consume(2)
 			}
 
+			if sc.unknownMode == unknownShortcodePassthrough {
+				sc.literal = literal()
+			}
+
 			return sc, nil
 		case currItem.IsText():
 			sc.inner = append(sc.inner, currItem.ValStr(source))
@@ -619,7 +648,19 @@ Loop:
 			// Used to check if the template expects inner content.
 			templs := s.s.Tmpl().LookupVariants(sc.name)
 			if templs == nil {
-				return nil, fmt.Errorf("%s: template for shortcode %q not found", errorPrefix, sc.name)
+				sc.unknownMode = s.s.unknownShortcodeMode(sc.name)
+				switch sc.unknownMode {
+				case unknownShortcodeFail:
+					return nil, fmt.Errorf("%s: template for shortcode %q not found", errorPrefix, sc.name)
+				case unknownShortcodeWarn:
+					if s.p != nil {
+						s.s.Log.Warnf("template for shortcode %q not found in page %q", sc.name, s.p.pathOrTitle())
+					} else {
+						s.s.Log.Warnf("template for shortcode %q not found", sc.name)
+					}
+				}
+				sc.unknownIsInner = hasShortcodeClose(sc.name, source, pt)
+				continue
 			}
 
 			sc.info = templs[0].(tpl.Info)
@@ -668,6 +709,21 @@ Loop:
 	return sc, nil
 }
 
+// hasShortcodeClose reports whether there's a closing tag for the shortcode
+// with the given name ahead in pt, e.g. {{< /name >}}.
+func hasShortcodeClose(name string, source []byte, pt *pageparser.Iterator) bool {
+	var found, isClose bool
+	pt.PeekWalk(func(item pageparser.Item) bool {
+		if isClose && item.IsShortcodeName() && item.ValStr(source) == name {
+			found = true
+			return false
+		}
+		isClose = item.IsShortcodeClose()
+		return !item.IsDone()
+	})
+	return found
+}
+
 // Replace prefixed shortcode tokens with the real content.
 // Note: This function will rewrite the input slice.
 func replaceShortcodeTokens(source []byte, replacements map[string]string) ([]byte, error) {
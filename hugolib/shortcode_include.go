@@ -0,0 +1,127 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"fmt"
+	"html/template"
+	"strings"
+	"sync"
+
+	"github.com/gohugoio/hugo/hugofs/files"
+	"github.com/gohugoio/hugo/identity"
+	"github.com/gohugoio/hugo/resources/page"
+)
+
+// Include returns the rendered content of the page with the given ref,
+// resolved relative to the current page as in GetPage.
+// It fails if the included page, directly or indirectly, includes the
+// current page.
+func (scp *ShortcodeWithPage) Include(ref string) (template.HTML, error) {
+	p, ok := mustUnwrapPage(scp.Page).(*pageState)
+	if !ok {
+		return "", fmt.Errorf("include: %T not supported", scp.Page)
+	}
+
+	included, err := p.GetPage(ref)
+	if err != nil {
+		return "", err
+	}
+	if included == nil || included == page.NopPage {
+		return "", fmt.Errorf("include: page %q not found", ref)
+	}
+	ip, ok := included.(*pageState)
+	if !ok {
+		return "", fmt.Errorf("include: %T not supported", included)
+	}
+
+	if err := p.s.h.contentIncludes.add(p, ip); err != nil {
+		return "", err
+	}
+
+	if !ip.File().IsZero() && p.pageOutput.cp != nil {
+		// Re-render the content of this page when the included page changes.
+		p.pageOutput.cp.trackDependency(identity.NewPathIdentity(files.ComponentFolderContent, ip.File().Path()))
+	}
+
+	c, err := ip.Content()
+	if err != nil {
+		return "", err
+	}
+
+	// Any error rendering the included page is reported when rendering it.
+	content, _ := c.(template.HTML)
+
+	return content, nil
+}
+
+// contentIncludes holds the pages included in other pages' content, used to
+// detect include cycles, which would otherwise block forever.
+type contentIncludes struct {
+	mu       sync.Mutex
+	includes map[*pageState]map[*pageState]bool
+}
+
+// add registers that from includes to. It fails if this creates a cycle.
+func (c *contentIncludes) add(from, to *pageState) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.includes == nil {
+		c.includes = make(map[*pageState]map[*pageState]bool)
+	}
+
+	if path := c.pathTo(to, from, make(map[*pageState]bool)); path != nil {
+		var titles []string
+		for _, p := range append([]*pageState{from}, path...) {
+			titles = append(titles, p.pathOrTitle())
+		}
+		return fmt.Errorf("include cycle detected: %s", strings.Join(titles, " -> "))
+	}
+
+	if c.includes[from] == nil {
+		c.includes[from] = make(map[*pageState]bool)
+	}
+	c.includes[from][to] = true
+
+	return nil
+}
+
+// pathTo returns the include path from p to target, including both,
+// or nil if target is not included from p.
+func (c *contentIncludes) pathTo(p, target *pageState, seen map[*pageState]bool) []*pageState {
+	if p == target {
+		return []*pageState{p}
+	}
+	if seen[p] {
+		return nil
+	}
+	seen[p] = true
+
+	for pp := range c.includes[p] {
+		if path := c.pathTo(pp, target, seen); path != nil {
+			return append([]*pageState{p}, path...)
+		}
+	}
+
+	return nil
+}
+
+// reset forgets the pages included by p, to be called when its content is
+// rendered again.
+func (c *contentIncludes) reset(p *pageState) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.includes, p)
+}
@@ -1055,3 +1055,173 @@ title: "p1"
 	`)
 
 }
+
+func TestShortcodeInclude(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404", "section"]
+-- content/snippets/_index.md --
+---
+cascade:
+  _build:
+    render: never
+    list: never
+---
+-- content/snippets/note.md --
+---
+title: Note
+---
+**Note:** {{< param "title" >}}.
+-- content/p1.md --
+---
+title: P1
+---
+Before.
+
+{{< include "/snippets/note" >}}
+
+After.
+-- layouts/index.html --
+Home.
+-- layouts/_default/single.html --
+Content: {{ .Content }}|
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			Running:     true,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html", "<p>Before.</p>\n<p><strong>Note:</strong> Note.</p>\n\n<p>After.</p>")
+	b.AssertDestinationExists("snippets/note/index.html", false)
+
+	b.EditFileReplace("content/snippets/note.md", func(s string) string { return strings.Replace(s, "Note:", "Edited note:", 1) }).Build()
+
+	b.AssertFileContent("public/p1/index.html", "<strong>Edited note:</strong> Note.")
+}
+
+func TestShortcodeIncludeCycle(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404", "section"]
+-- content/p1.md --
+---
+title: P1
+---
+{{< include "p2" >}}
+-- content/p2.md --
+---
+title: P2
+---
+{{< include "p3" >}}
+-- content/p3.md --
+---
+title: P3
+---
+{{< include "p1" >}}
+-- content/p4.md --
+---
+title: P4
+---
+{{< include "p4" >}}
+-- layouts/index.html --
+Home.
+-- layouts/_default/single.html --
+Content: {{ .Content }}|
+`
+
+	for _, disable := range []string{"p4", "p1"} {
+		b, err := NewIntegrationTestBuilder(
+			IntegrationTestConfig{
+				T:           t,
+				TxtarString: strings.Replace(files, "title: "+strings.ToUpper(disable), "title: "+strings.ToUpper(disable)+"\n_build:\n  render: never\n  list: never", 1),
+			},
+		).BuildE()
+
+		b.Assert(err, qt.IsNotNil)
+		b.Assert(err.Error(), qt.Contains, "include cycle detected")
+	}
+}
+
+func TestShortcodeUnknownModes(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404", "section"]
+[unknownShortcodes]
+mode = "warn"
+[unknownShortcodes.names]
+chart = "passthrough"
+oldbox = "ignore"
+-- content/p1.md --
+---
+title: P1
+---
+A: {{< nosuch >}}|
+B: {{< chart type="pie" >}}Data{{< /chart >}}|
+C: {{< oldbox >}}Old{{< /oldbox >}}|
+D: {{< chart "bar" />}}|
+-- layouts/index.html --
+Home.
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		"A: |",
+		`B: {{< chart type="pie" >}}Data{{< /chart >}}|`,
+		"C: |",
+		`D: {{< chart "bar" />}}|`,
+	)
+	b.AssertLogContains(`template for shortcode "nosuch" not found`)
+	b.Assert(b.logBuff.String(), qt.Not(qt.Contains), "chart")
+	b.Assert(b.logBuff.String(), qt.Not(qt.Contains), "oldbox")
+
+	b, err := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.Replace(files, `oldbox = "ignore"`, `oldbox = "fail"`, 1),
+		},
+	).BuildE()
+
+	b.AssertIsFileError(err)
+	b.Assert(err.Error(), qt.Contains, `p1.md:6:4": failed to extract shortcode: template for shortcode "oldbox" not found`)
+
+	// errorLevels.unknownShortcode is an alias for mode, mode wins.
+	b = NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.Replace(files, `[unknownShortcodes]`, "[errorLevels]\nunknownShortcode = \"ignore\"\n[unknownShortcodes]", 1),
+		},
+	).Build()
+
+	b.AssertLogContains(`template for shortcode "nosuch" not found`)
+
+	// Without mode, errorLevels.unknownShortcode is used.
+	b = NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.Replace(files, "[unknownShortcodes]\nmode = \"warn\"", "[errorLevels]\nunknownShortcode = \"ignore\"\n[unknownShortcodes]", 1),
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html", "A: |")
+	b.Assert(b.logBuff.String(), qt.Not(qt.Contains), "nosuch")
+}
@@ -0,0 +1,125 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/constants"
+	"github.com/gohugoio/hugo/common/loggers"
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/mitchellh/mapstructure"
+)
+
+// unknownShortcodeMode is how a shortcode without a template is handled.
+type unknownShortcodeMode int
+
+const (
+	// Fail the build with an error pointing to the shortcode.
+	unknownShortcodeFail unknownShortcodeMode = iota + 1
+
+	// Log a warning and render nothing.
+	unknownShortcodeWarn
+
+	// Render nothing.
+	unknownShortcodeIgnore
+
+	// Render the shortcode as is, e.g. for a JavaScript library to process.
+	unknownShortcodePassthrough
+)
+
+func parseUnknownShortcodeMode(s string) (unknownShortcodeMode, error) {
+	switch strings.ToLower(s) {
+	case "fail", "error":
+		return unknownShortcodeFail, nil
+	case "warn", "warning":
+		return unknownShortcodeWarn, nil
+	case "ignore":
+		return unknownShortcodeIgnore, nil
+	case "passthrough":
+		return unknownShortcodePassthrough, nil
+	}
+	return 0, fmt.Errorf("invalid mode %q, must be one of fail, warn, ignore or passthrough", s)
+}
+
+// unknownShortcodesConfig is the unknownShortcodes section of the site config.
+type unknownShortcodesConfig struct {
+	// The mode for all unknown shortcodes. The errorLevels.unknownShortcode
+	// setting is an alias for this, used if this is not set.
+	mode unknownShortcodeMode
+
+	// The mode for unknown shortcodes by name.
+	names map[string]unknownShortcodeMode
+}
+
+func decodeUnknownShortcodesConfig(v any) (unknownShortcodesConfig, error) {
+	var c unknownShortcodesConfig
+	if v == nil {
+		return c, nil
+	}
+
+	var cfg struct {
+		Mode  string
+		Names map[string]string
+	}
+
+	m, err := maps.ToStringMapE(v)
+	if err != nil {
+		return c, err
+	}
+	if err := mapstructure.WeakDecode(m, &cfg); err != nil {
+		return c, err
+	}
+
+	if cfg.Mode != "" {
+		if c.mode, err = parseUnknownShortcodeMode(cfg.Mode); err != nil {
+			return c, err
+		}
+	}
+
+	for name, s := range cfg.Names {
+		mode, err := parseUnknownShortcodeMode(s)
+		if err != nil {
+			return c, fmt.Errorf("shortcode %q: %w", name, err)
+		}
+		if c.names == nil {
+			c.names = make(map[string]unknownShortcodeMode)
+		}
+		c.names[strings.ToLower(name)] = mode
+	}
+
+	return c, nil
+}
+
+// unknownShortcodeMode returns how to handle the shortcode with the given
+// name if it has no template.
+func (s *Site) unknownShortcodeMode(name string) unknownShortcodeMode {
+	cfg := s.siteCfg.unknownShortcodes
+	if mode, found := cfg.names[strings.ToLower(name)]; found {
+		return mode
+	}
+	if cfg.mode != 0 {
+		return cfg.mode
+	}
+
+	switch s.ErrorLevels.Level(constants.ErrClassUnknownShortcode, loggers.ErrorLevelError) {
+	case loggers.ErrorLevelIgnore:
+		return unknownShortcodeIgnore
+	case loggers.ErrorLevelWarning:
+		return unknownShortcodeWarn
+	default:
+		return unknownShortcodeFail
+	}
+}
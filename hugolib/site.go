@@ -14,6 +14,7 @@
 package hugolib
 
 import (
+	"context"
 	"fmt"
 	"html/template"
 	"io"
@@ -161,6 +162,11 @@ type Site struct {
 
 	menus navigation.Menus
 
+	// Reset with the menus above.
+	menuTrails *menuTrails
+
+	sectionTree *SectionTreeNode
+
 	// Shortcut to the home page. Note that this may be nil if
 	// home page, for some odd reason, is disabled.
 	home *pageState
@@ -168,6 +174,9 @@ type Site struct {
 	// The last modification date of this site.
 	lastmod time.Time
 
+	// Pages to be published or to expire in the future.
+	scheduled scheduledChanges
+
 	// Lazily loaded site dependencies
 	init *siteInit
 }
@@ -195,8 +204,13 @@ type siteConfigHolder struct {
 	sitemap          config.Sitemap
 	taxonomiesConfig taxonomiesConfig
 	timeout          time.Duration
+	renderTimeout    time.Duration
 	hasCJKLanguage   bool
 	enableEmoji      bool
+
+	unknownShortcodes unknownShortcodesConfig
+	termAliases       termAliasesConfig
+	contentFilter     page.Filter
 }
 
 // Lazily loaded site dependencies.
@@ -205,6 +219,7 @@ type siteInit struct {
 	prevNextInSection *lazy.Init
 	menus             *lazy.Init
 	taxonomies        *lazy.Init
+	sectionTree       *lazy.Init
 }
 
 func (init *siteInit) Reset() {
@@ -212,6 +227,7 @@ func (init *siteInit) Reset() {
 	init.prevNextInSection.Reset()
 	init.menus.Reset()
 	init.taxonomies.Reset()
+	init.sectionTree.Reset()
 }
 
 func (s *Site) initInit(init *lazy.Init, pctx pageContext) bool {
@@ -320,6 +336,11 @@ func (s *Site) prepareInits() {
 		err := s.pageMap.assembleTaxonomies()
 		return nil, err
 	})
+
+	s.init.sectionTree = init.Branch(func() (any, error) {
+		s.sectionTree = newSectionTree(s.home, s.Info.sectionTreeHiddenParam)
+		return nil, nil
+	})
 }
 
 type siteRenderingContext struct {
@@ -532,12 +553,44 @@ But this also means that your site configuration may not do what you expect. If
 		}
 	}
 
+	// Disabled by default.
+	var renderTimeout time.Duration
+	if cfg.Language.IsSet("renderTimeout") {
+		v := cfg.Language.Get("renderTimeout")
+		d, err := types.ToDurationE(v)
+		if err == nil {
+			renderTimeout = d
+		}
+	}
+
+	unknownShortcodes, err := decodeUnknownShortcodesConfig(cfg.Language.Get("unknownShortcodes"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode unknownShortcodes config: %w", err)
+	}
+	if unknownShortcodes.mode != 0 && cfg.Cfg.IsSet("errorLevels.unknownShortcode") {
+		cfg.Logger.Warnf("Both unknownShortcodes.mode and errorLevels.unknownShortcode are set, using unknownShortcodes.mode.")
+	}
+
+	termAliases, err := decodeTermAliasesConfig(cfg.Language.Get("termAliases"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode termAliases config: %w", err)
+	}
+
+	contentFilter, err := page.DecodeFilter(cfg.Language.Get("contentFilter"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode contentFilter config: %w", err)
+	}
+
 	siteConfig := siteConfigHolder{
-		sitemap:          config.DecodeSitemap(config.Sitemap{Priority: -1, Filename: "sitemap.xml"}, cfg.Language.GetStringMap("sitemap")),
-		taxonomiesConfig: taxonomies,
-		timeout:          timeout,
-		hasCJKLanguage:   cfg.Language.GetBool("hasCJKLanguage"),
-		enableEmoji:      cfg.Language.Cfg.GetBool("enableEmoji"),
+		sitemap:           config.DecodeSitemap(config.Sitemap{Priority: -1, Filename: "sitemap.xml"}, cfg.Language.GetStringMap("sitemap")),
+		taxonomiesConfig:  taxonomies,
+		timeout:           timeout,
+		renderTimeout:     renderTimeout,
+		hasCJKLanguage:    cfg.Language.GetBool("hasCJKLanguage"),
+		enableEmoji:       cfg.Language.Cfg.GetBool("enableEmoji"),
+		unknownShortcodes: unknownShortcodes,
+		termAliases:       termAliases,
+		contentFilter:     contentFilter,
 	}
 
 	var siteBucket *pagesMapBucket
@@ -664,15 +717,17 @@ type SiteInfo struct {
 
 	BuildDrafts bool
 
-	canonifyURLs bool
-	relativeURLs bool
-	uglyURLs     func(p page.Page) bool
+	canonifyURLs   bool
+	relativeURLs   bool
+	canonicalLinks bool
+	uglyURLs       func(p page.Page) bool
 
 	owner                          *HugoSites
 	s                              *Site
 	language                       *langs.Language
 	defaultContentLanguageInSubdir bool
 	sectionPagesMenu               string
+	sectionTreeHiddenParam         string
 }
 
 func (s *SiteInfo) Pages() page.Pages {
@@ -695,6 +750,10 @@ func (s *SiteInfo) LastChange() time.Time {
 	return s.s.lastmod
 }
 
+func (s *SiteInfo) Lastmod() time.Time {
+	return s.s.lastmod
+}
+
 func (s *SiteInfo) Title() string {
 	return s.title
 }
@@ -815,13 +874,14 @@ type siteRefLinker struct {
 }
 
 func newSiteRefLinker(cfg config.Provider, s *Site) (siteRefLinker, error) {
-	logger := s.Log.Error()
+	defaultLevel := loggers.ErrorLevelError
 
 	notFoundURL := cfg.GetString("refLinksNotFoundURL")
 	errLevel := cfg.GetString("refLinksErrorLevel")
 	if strings.EqualFold(errLevel, "warning") {
-		logger = s.Log.Warn()
+		defaultLevel = loggers.ErrorLevelWarning
 	}
+	logger := s.ErrorLevels.Level(constants.ErrClassRefNotFound, defaultLevel).Logger(s.Log)
 	return siteRefLinker{s: s, errorLogger: logger, notFoundURL: notFoundURL}, nil
 }
 
@@ -1380,9 +1440,11 @@ func (s *Site) initializeSiteInfo() error {
 		Languages:                      languages,
 		defaultContentLanguageInSubdir: defaultContentInSubDir,
 		sectionPagesMenu:               lang.GetString("sectionPagesMenu"),
+		sectionTreeHiddenParam:         lang.GetString("sectionTreeHiddenParam"),
 		BuildDrafts:                    s.Cfg.GetBool("buildDrafts"),
 		canonifyURLs:                   s.Cfg.GetBool("canonifyURLs"),
 		relativeURLs:                   s.Cfg.GetBool("relativeURLs"),
+		canonicalLinks:                 s.Cfg.GetString("urlMode") == urlModeCanonical,
 		uglyURLs:                       uglyURLs,
 		permalinks:                     permalinks,
 		owner:                          s.h,
@@ -1480,6 +1542,7 @@ func (s *SiteInfo) createNodeMenuEntryURL(in string) string {
 
 func (s *Site) assembleMenus() {
 	s.menus = make(navigation.Menus)
+	s.menuTrails = &menuTrails{menus: s.menus}
 
 	type twoD struct {
 		MenuName, EntryName string
@@ -1772,6 +1835,16 @@ func (s *Site) renderAndWritePage(statCounter *uint64, name string, targetPath s
 			pd.AddHugoGeneratorTag = !s.Cfg.GetBool("disableHugoGeneratorInject")
 		}
 
+		if s.Info.canonicalLinks && p.Kind() != kind404 {
+			pd.CanonicalURL = p.Permalink()
+		}
+
+		if s.Cfg.GetBool("logSocialWarnings") && p.Kind() != kind404 {
+			if problems := checkSocialMeta(renderBuffer.Bytes()); len(problems) > 0 {
+				s.Log.Warnf("Page %q (%s) will render poorly when shared: %s", p.pathOrTitle(), targetPath, strings.Join(problems, ", "))
+			}
+		}
+
 	}
 
 	return s.publisher.Publish(pd)
@@ -1803,6 +1876,14 @@ func (hr hookRendererTemplate) RenderCodeblock(w hugio.FlexiWriter, ctx hooks.Co
 	return hr.templateHandler.Execute(hr.templ, w, ctx)
 }
 
+func (hr hookRendererTemplate) RenderBlockquote(w hugio.FlexiWriter, ctx hooks.BlockquoteContext) error {
+	return hr.templateHandler.Execute(hr.templ, w, ctx)
+}
+
+func (hr hookRendererTemplate) RenderTable(w hugio.FlexiWriter, ctx hooks.TableContext) error {
+	return hr.templateHandler.Execute(hr.templ, w, ctx)
+}
+
 func (hr hookRendererTemplate) ResolvePosition(ctx any) text.Position {
 	return hr.resolvePosition(ctx)
 }
@@ -1817,7 +1898,17 @@ func (s *Site) renderForTemplate(name, outputFormat string, d any, w io.Writer,
 		return nil
 	}
 
-	if err = s.Tmpl().Execute(templ, w, d); err != nil {
+	ctx := context.Background()
+	if s.siteCfg.renderTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.siteCfg.renderTimeout)
+		defer cancel()
+	}
+
+	if err = s.Tmpl().ExecuteWithContext(ctx, templ, w, d); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("render of %q timed out after %s. You may have an infinite loop in a template, or your site may have pages that take longer to render than the `renderTimeout` limit in your Hugo config file: %w", name, s.siteCfg.renderTimeout, err)
+		}
 		return fmt.Errorf("render of %q failed: %w", name, err)
 	}
 	return
@@ -1902,8 +1993,13 @@ func (s *Site) newPage(
 }
 
 func (s *Site) shouldBuild(p page.Page) bool {
-	return shouldBuild(s.BuildFuture, s.BuildExpired,
+	if !p.IsHome() && !s.siteCfg.contentFilter.Matches(p) {
+		return false
+	}
+	build := shouldBuild(s.BuildFuture, s.BuildExpired,
 		s.BuildDrafts, p.Draft(), p.PublishDate(), p.ExpiryDate())
+	s.scheduled.add(p, build, s.BuildDrafts)
+	return build
 }
 
 func shouldBuild(buildFuture bool, buildExpired bool, buildDrafts bool, Draft bool,
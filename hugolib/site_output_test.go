@@ -14,6 +14,7 @@
 package hugolib
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
@@ -646,3 +647,331 @@ WordCount: {{ .WordCount }}
 	b.AssertFileContent("public/outputs-empty/index.html", "HTML:", "Word1. Word2.")
 	b.AssertFileContent("public/outputs-string/index.html", "O1:", "Word1. Word2.")
 }
+
+func TestSocialMetaWarnings(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "section", "home", "sitemap", "RSS"]
+logSocialWarnings = true
+-- content/good.md --
+---
+title: Good
+---
+-- content/bad.md --
+---
+title: Bad
+---
+-- content/small.md --
+---
+title: Small
+---
+-- layouts/_default/single.html --
+<html><head>
+{{ if ne .Title "Bad" }}
+<meta property="og:title" content="{{ .Title }}" />
+<meta name="twitter:card" content="summary" />
+{{ end }}
+{{ if eq .Title "Good" }}
+<meta property="og:image" content="https://example.org/og.png" />
+<meta property="og:image:width" content="1200" />
+<meta property="og:image:height" content="630" />
+{{ end }}
+{{ if eq .Title "Small" }}
+<meta property="og:image" content="/og.png" />
+<meta property="og:image:width" content="100" />
+{{ end }}
+</head><body><meta property="og:image" content="https://example.org/body.png" /></body></html>
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertLogMatches(`bad.md.*missing og:title, missing og:image, missing twitter:card`)
+	b.AssertLogMatches(`small.md.*og:image "/og.png" is not an absolute URL, og:image:width 100 is smaller than 200`)
+	b.Assert(b.H.Log.LogCounters().WarnCounter.Count(), qt.Equals, uint64(2))
+}
+
+func TestOutputFormatFilenameInFrontMatter(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404", "section"]
+-- content/_index.md --
++++
+title = "Home"
+outputs = ["html", { json = "manifest.webmanifest" }]
++++
+-- content/p1.md --
+---
+title: P1
+outputs:
+  - html
+  - json: data.json
+---
+-- layouts/index.html --
+Home: {{ with .OutputFormats.Get "json" }}{{ .RelPermalink }}{{ end }}
+-- layouts/index.json --
+{"name": "{{ .Title }}"}
+-- layouts/_default/single.html --
+Single: {{ with .OutputFormats.Get "json" }}{{ .RelPermalink }}{{ end }}
+-- layouts/_default/single.json --
+{"title": "{{ .Title }}"}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html", "Home: /manifest.webmanifest")
+	b.AssertFileContent("public/manifest.webmanifest", `{"name": "Home"}`)
+	b.AssertFileContent("public/p1/index.html", "Single: /p1/data.json")
+	b.AssertFileContent("public/p1/data.json", `{"title": "P1"}`)
+	b.AssertDestinationExists("index.json", false)
+	b.AssertDestinationExists("p1/index.json", false)
+}
+
+func TestOutputFormatFilenameInFrontMatterCollision(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404", "section"]
+-- content/p1.md --
+---
+title: P1
+outputs:
+  - html
+  - json: index.html
+---
+-- layouts/_default/single.html --
+Single.
+-- layouts/_default/single.json --
+JSON.
+-- layouts/index.html --
+Home.
+`
+
+	b, err := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `p1.md`)
+	b.Assert(err.Error(), qt.Contains, `output formats "HTML" and "JSON" have the same target path "/p1/index.html"`)
+}
+
+func TestPrintOutputFormat(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+title = "My Site"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+[outputs]
+section = ["html", "print"]
+-- content/docs/_index.md --
+---
+title: Docs
+---
+Docs intro.
+-- content/docs/p1.md --
+---
+title: P1
+weight: 1
+---
+## P1 Heading
+-- content/docs/sub/_index.md --
+---
+title: Sub
+weight: 2
+---
+-- content/docs/sub/p2.md --
+---
+title: P2
+---
+## P2 Heading
+-- layouts/index.html --
+Home.
+-- layouts/_default/single.html --
+Single.
+-- layouts/_default/list.html --
+List: {{ with .OutputFormats.Get "print" }}{{ .RelPermalink }}{{ end }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/docs/index.html", "List: /docs/print/")
+	b.AssertFileContent("public/docs/print/index.html",
+		`<title>Docs | My Site</title>`,
+		`<link rel="canonical" href="https://example.org/docs/">`,
+		`<h1>Docs</h1>`,
+		`<p>Docs intro.</p>`,
+		`<h2>P1</h2>`,
+		`<h3 id="p1-heading">P1 Heading</h3>`,
+		`<h2>Sub</h2>`,
+		`<h3>P2</h3>`,
+		`<h4 id="p2-heading">P2 Heading</h4>`,
+	)
+}
+
+func TestSearchIndexOutputFormat(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+[outputs]
+home = ["html", "searchindex"]
+section = ["html", "searchindex"]
+[outputFormats.SearchIndex]
+baseName = "search"
+-- content/docs/p1.md --
+---
+title: "P1 & Co"
+tags: ["a", "b"]
+---
+Some *text* about <b>p1</b>.
+-- content/blog/p2.md --
+---
+title: P2
+---
+P2 content.
+-- content/blog/hidden.md --
+---
+title: Hidden
+_build:
+  render: never
+---
+-- layouts/index.html --
+Home.
+-- layouts/_default/list.html --
+List.
+-- layouts/_default/list.json --
+Not used.
+-- layouts/_default/single.html --
+Single.
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	var index []map[string]any
+	b.Assert(json.Unmarshal([]byte(b.FileContent("public/search.json")), &index), qt.IsNil)
+	b.Assert(index, qt.HasLen, 2)
+
+	byTitle := make(map[string]map[string]any)
+	for _, entry := range index {
+		byTitle[entry["title"].(string)] = entry
+	}
+
+	p1 := byTitle["P1 & Co"]
+	b.Assert(p1, qt.IsNotNil)
+	b.Assert(p1["permalink"], qt.Equals, "https://example.org/docs/p1/")
+	b.Assert(p1["id"], qt.Equals, b.H.Sites[0].getPage(page.KindPage, "docs/p1.md").ID())
+	b.Assert(p1["tags"], qt.DeepEquals, []any{"a", "b"})
+	b.Assert(p1["content"], qt.Equals, "Some text about p1.\n")
+	b.Assert(p1["summary"], qt.Equals, "Some text about p1.")
+	b.Assert(byTitle["P2"]["tags"], qt.DeepEquals, []any{})
+
+	var blogIndex []map[string]any
+	b.Assert(json.Unmarshal([]byte(b.FileContent("public/blog/search.json")), &blogIndex), qt.IsNil)
+	b.Assert(blogIndex, qt.HasLen, 1)
+	b.Assert(blogIndex[0]["title"], qt.Equals, "P2")
+}
+
+func TestOutputFormatFilenameInFrontMatterCollisionAcrossPages(t *testing.T) {
+	t.Parallel()
+
+	common := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404", "section"]
+-- layouts/_default/single.html --
+Single.
+-- layouts/_default/single.json --
+JSON.
+-- layouts/index.html --
+Home.
+`
+
+	for _, test := range []struct {
+		name   string
+		files  string
+		expect string
+	}{
+		{
+			"Page resource",
+			`
+-- content/p1/index.md --
+---
+title: P1
+outputs:
+  - html
+  - json: data.json
+---
+-- content/p1/data.json --
+{}
+`,
+			`output format "JSON" of "/content/p1/index.md" and resource "data.json" of "/content/p1/index.md" have the same target path "/p1/data.json"`,
+		},
+		{
+			"Other page",
+			`
+-- content/p1.md --
+---
+title: P1
+url: /p2/
+---
+-- content/p2.md --
+---
+title: P2
+outputs:
+  - json: index.html
+---
+`,
+			`output format "HTML" of "/content/p1.md" and output format "JSON" of "/content/p2.md" have the same target path "/p2/index.html"`,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := NewIntegrationTestBuilder(
+				IntegrationTestConfig{
+					T:           t,
+					TxtarString: common + test.files,
+				},
+			).BuildE()
+
+			b.Assert(err, qt.IsNotNil)
+			b.Assert(err.Error(), qt.Contains, test.expect)
+		})
+	}
+}
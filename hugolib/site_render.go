@@ -15,10 +15,16 @@ package hugolib
 
 import (
 	"fmt"
+	"os"
 	"path"
+	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/gohugoio/hugo/common/constants"
+	"github.com/gohugoio/hugo/common/loggers"
+	"github.com/gohugoio/hugo/helpers"
 	"github.com/gohugoio/hugo/tpl"
 
 	"github.com/gohugoio/hugo/config"
@@ -29,6 +35,7 @@ import (
 
 	"github.com/gohugoio/hugo/resources/page"
 	"github.com/gohugoio/hugo/resources/page/pagemeta"
+	"github.com/spf13/afero"
 )
 
 type siteRenderContext struct {
@@ -128,6 +135,14 @@ func pageRenderer(
 			continue
 		}
 
+		if !found && p.IsHome() && p.f.IsHTML && !s.hasLayoutFiles() {
+			// Make sure that a new site without any layouts gets a home page.
+			if templ = s.lookupLayouts("_internal/_default/home.html"); templ != nil {
+				found = true
+				s.Log.Warnf("found no layout file for %q for kind %q: Using a minimal built-in template.", p.f.Name, p.Kind())
+			}
+		}
+
 		if !found {
 			s.logMissingLayout("", p.Layout(), p.Kind(), p.f.Name)
 			continue
@@ -135,6 +150,12 @@ func pageRenderer(
 
 		targetPath := p.targetPaths().TargetFilename
 
+		if s.h.incremental != nil && s.h.incremental.isUnchanged(p, templ, targetPath) {
+			continue
+		}
+
+		start := time.Now()
+
 		if err := s.renderAndWritePage(&s.PathSpec.ProcessingStats.Pages, "page "+p.Title(), targetPath, p, templ); err != nil {
 			results <- err
 		}
@@ -144,12 +165,33 @@ func pageRenderer(
 				results <- err
 			}
 		}
+
+		if s.h.Metrics != nil {
+			s.h.Metrics.MeasurePageSince(filepath.ToSlash(strings.TrimPrefix(targetPath, helpers.FilePathSeparator)), start)
+		}
 	}
 }
 
+// hasLayoutFiles reports whether the project, its themes or modules have
+// any layout file.
+func (s *Site) hasLayoutFiles() bool {
+	errFound := errors.New("found")
+	err := afero.Walk(s.BaseFs.Layouts.Fs, "", func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if !info.IsDir() {
+			return errFound
+		}
+		return nil
+	})
+	return err == errFound
+}
+
 func (s *Site) logMissingLayout(name, layout, kind, outputFormat string) {
-	log := s.Log.Warn()
-	if name != "" && infoOnMissingLayout[name] {
+	level := s.ErrorLevels.Level(constants.ErrClassMissingLayout, loggers.ErrorLevelWarning)
+	log := level.Logger(s.Log)
+	if level == loggers.ErrorLevelWarning && name != "" && infoOnMissingLayout[name] {
 		log = s.Log.Info()
 	}
 
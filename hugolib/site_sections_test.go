@@ -373,3 +373,145 @@ Next: {{ with .NextInSection }}{{ .RelPermalink }}{{ end }}|
 	b.AssertFileContent("public/blog/cool/cool2/index.html",
 		"Prev: |", "Next: /blog/cool/cool1/|")
 }
+
+func TestAncestorsAndBreadcrumbs(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["RSS", "sitemap", "robotsTXT", "404"]
+-- content/_index.md --
+---
+title: Home
+---
+-- content/docs/_index.md --
+---
+title: Docs
+---
+-- content/docs/guides/_index.md --
+---
+title: Guides
+---
+-- content/docs/guides/install/index.md --
+---
+title: Install
+tags: [hugo]
+---
+-- content/root.md --
+---
+title: Root
+---
+-- layouts/_default/single.html --
+Ancestors: {{ range .Ancestors }}{{ .Title }}|{{ end }}
+Breadcrumbs: {{ range .Breadcrumbs }}{{ .Title }}|{{ end }}
+-- layouts/_default/list.html --
+Ancestors: {{ range .Ancestors }}{{ .Title }}|{{ end }}
+Breadcrumbs: {{ range .Breadcrumbs }}{{ .Title }}|{{ end }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/docs/guides/install/index.html",
+		"Ancestors: Home|Docs|Guides|\nBreadcrumbs: Home|Docs|Guides|Install|")
+	b.AssertFileContent("public/docs/index.html",
+		"Ancestors: Home|\nBreadcrumbs: Home|Docs|")
+	b.AssertFileContent("public/root/index.html",
+		"Ancestors: Home|\nBreadcrumbs: Home|Root|")
+	b.AssertFileContent("public/index.html",
+		"Ancestors: \nBreadcrumbs: Home|")
+	b.AssertFileContent("public/tags/hugo/index.html",
+		"Ancestors: Home|Tags|\nBreadcrumbs: Home|Tags|hugo|")
+}
+
+func TestSectionTree(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+sectionTreeHiddenParam = "bookHidden"
+-- content/docs/_index.md --
+---
+title: Docs
+---
+-- content/docs/b.md --
+---
+title: B
+weight: 1
+---
+-- content/docs/a.md --
+---
+title: A
+weight: 2
+---
+-- content/docs/sub/_index.md --
+---
+title: Sub
+weight: 3
+---
+-- content/docs/sub/c.md --
+---
+title: C
+---
+{{< nav >}}
+-- content/docs/internal/_index.md --
+---
+title: Internal
+bookHidden: true
+cascade:
+  bookHidden: true
+---
+-- content/docs/internal/d.md --
+---
+title: D
+---
+-- content/blog/_index.md --
+---
+title: Blog
+---
+-- content/blog/e.md --
+---
+title: E
+bookHidden: true
+---
+-- layouts/index.html --
+Home.
+-- layouts/_default/list.html --
+List.
+-- layouts/_default/single.html --
+{{ define "tree" }}{{ range .nodes }}[{{ .Page.Title }}{{ if .IsActive $.current }} active{{ end }}{{ if and .IsSection (.IsActive $.current) }}: {{ template "tree" (dict "nodes" .Children "current" $.current) }}{{ end }}]{{ end }}{{ end -}}
+Tree: {{ template "tree" (dict "nodes" site.SectionTree.Children "current" .) }}|
+Section: {{ with site.SectionTree.Get .CurrentSection }}{{ len .Children }}{{ end }}|
+Content: {{ .Content }}|
+-- layouts/shortcodes/nav.html --
+{{ with site.SectionTree.Get .Page }}Node: {{ .Page.Title }}{{ end }}|Sub active: {{ (site.SectionTree.Get .Page.CurrentSection).IsActive .Page }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/docs/sub/c/index.html",
+		"Tree: [Blog][Docs active: [B][A][Sub active: [C active]]]|",
+		"Section: 1|",
+		"Node: C|Sub active: true",
+	)
+	b.AssertFileContent("public/docs/a/index.html",
+		"Tree: [Blog][Docs active: [B][A active][Sub]]|",
+		"Section: 3|",
+	)
+	b.AssertFileContent("public/docs/internal/d/index.html",
+		"Tree: [Blog][Docs active: [B][A][Sub]]|",
+		"Section: |",
+	)
+}
@@ -0,0 +1,126 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"strings"
+
+	"github.com/gohugoio/hugo/resources/page"
+	"github.com/spf13/cast"
+)
+
+// SectionTreeNode is a node in the navigation tree of the site's sections
+// and regular pages, see SiteInfo.SectionTree.
+type SectionTreeNode struct {
+	Page page.Page
+
+	// The regular pages and sections below a section, in the default sort
+	// order, i.e. by weight first.
+	// Pages with the sectionTreeHiddenParam front matter parameter set to true
+	// are left out, including any pages below them.
+	Children []*SectionTreeNode
+
+	Parent *SectionTreeNode
+
+	// All nodes in the tree, shared by all the nodes.
+	index map[page.Page]*SectionTreeNode
+}
+
+// SectionTree returns the navigation tree of all the sections and regular
+// pages in this site, with the home page as its root.
+// It's built once per build, so large documentation sites don't have to build
+// it in templates for every page.
+func (s *SiteInfo) SectionTree() *SectionTreeNode {
+	s.s.init.sectionTree.Do()
+	return s.s.sectionTree
+}
+
+func newSectionTree(home page.Page, hiddenParam string) *SectionTreeNode {
+	if home == nil {
+		return nil
+	}
+
+	hiddenParam = strings.ToLower(hiddenParam)
+	isHidden := func(p page.Page) bool {
+		if hiddenParam == "" {
+			return false
+		}
+		return cast.ToBool(p.Params()[hiddenParam])
+	}
+
+	index := make(map[page.Page]*SectionTreeNode)
+
+	var add func(p page.Page, parent *SectionTreeNode) *SectionTreeNode
+	add = func(p page.Page, parent *SectionTreeNode) *SectionTreeNode {
+		n := &SectionTreeNode{Page: p, Parent: parent, index: index}
+		index[p] = n
+		if !p.IsNode() {
+			return n
+		}
+		for _, pp := range p.Pages() {
+			if (pp.Kind() != page.KindPage && pp.Kind() != page.KindSection) || isHidden(pp) {
+				continue
+			}
+			n.Children = append(n.Children, add(pp, n))
+		}
+		return n
+	}
+
+	return add(home, nil)
+}
+
+// Get returns the node for p in the tree, nil if not found.
+// This can be used to get the navigation tree of a given section or to find
+// the current page's node.
+func (n *SectionTreeNode) Get(p page.Page) *SectionTreeNode {
+	pn, _ := n.lookup(p)
+	return pn
+}
+
+// lookup finds the node for p, which may be wrapped, e.g. the .Page
+// in a shortcode.
+func (n *SectionTreeNode) lookup(p page.Page) (*SectionTreeNode, bool) {
+	pp, err := unwrapPage(p)
+	if err != nil || pp == nil {
+		return nil, false
+	}
+	pn, found := n.index[pp]
+	return pn, found
+}
+
+// IsSection returns whether this node is a section or the home page, i.e.
+// it may have children.
+func (n *SectionTreeNode) IsSection() bool {
+	return n.Page.IsNode()
+}
+
+// IsActive returns whether p is this node's page or one of the pages below it,
+// which is useful to only expand the current branch of a collapsed navigation.
+func (n *SectionTreeNode) IsActive(p page.Page) bool {
+	if p == nil {
+		return false
+	}
+	pn, found := n.lookup(p)
+	if !found {
+		// E.g. a hidden page.
+		isAncestor, _ := n.Page.IsAncestor(p)
+		return isAncestor
+	}
+	for ; pn != nil; pn = pn.Parent {
+		if pn == n {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// The smallest image Facebook will use for link previews.
+const minSocialImageSize = 200
+
+// checkSocialMeta looks for the Open Graph and Twitter Card meta tags in the
+// rendered HTML and returns a list of problems that will make the page look
+// poor when shared on social media.
+func checkSocialMeta(b []byte) []string {
+	meta := make(map[string]string)
+
+	z := html.NewTokenizer(bytes.NewReader(b))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			if z.Err() != io.EOF {
+				return []string{fmt.Sprintf("failed to parse HTML: %s", z.Err())}
+			}
+			break
+		}
+		if tt != html.StartTagToken && tt != html.SelfClosingTagToken {
+			continue
+		}
+		tok := z.Token()
+		if tok.Data == "body" {
+			break
+		}
+		if tok.Data != "meta" {
+			continue
+		}
+		var key, content string
+		for _, attr := range tok.Attr {
+			switch attr.Key {
+			case "property", "name":
+				key = strings.ToLower(attr.Val)
+			case "content":
+				content = strings.TrimSpace(attr.Val)
+			}
+		}
+		if strings.HasPrefix(key, "og:") || strings.HasPrefix(key, "twitter:") {
+			if _, found := meta[key]; !found {
+				meta[key] = content
+			}
+		}
+	}
+
+	var problems []string
+
+	for _, key := range []string{"og:title", "og:image", "twitter:card"} {
+		if meta[key] == "" {
+			problems = append(problems, fmt.Sprintf("missing %s", key))
+		}
+	}
+
+	if img := meta["og:image"]; img != "" && !strings.Contains(img, "://") {
+		problems = append(problems, fmt.Sprintf("og:image %q is not an absolute URL", img))
+	}
+
+	for _, key := range []string{"og:image:width", "og:image:height"} {
+		v, found := meta[key]
+		if !found {
+			continue
+		}
+		size, err := strconv.Atoi(v)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s %q is not a number", key, v))
+		} else if size < minSocialImageSize {
+			problems = append(problems, fmt.Sprintf("%s %d is smaller than %d", key, size, minSocialImageSize))
+		}
+	}
+
+	return problems
+}
@@ -95,4 +95,5 @@ aliases: [/Ali%d]
 	helpers.ProcessingStatsTable(&buff, stats...)
 
 	c.Assert(buff.String(), qt.Contains, "Pages            | 19 |  6")
+	c.Assert(buff.String(), qt.Contains, "Taxonomy terms   |  4 |  0")
 }
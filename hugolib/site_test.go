@@ -154,6 +154,7 @@ func TestLastChange(t *testing.T) {
 
 	c.Assert(s.Info.LastChange().IsZero(), qt.Equals, false)
 	c.Assert(s.Info.LastChange().Year(), qt.Equals, 2017)
+	c.Assert(s.Info.Lastmod(), qt.Equals, s.Info.LastChange())
 }
 
 // Issue #_index
@@ -1109,3 +1110,127 @@ ABC.
 		b.Assert(els.IDs, qt.HasLen, 1)
 	}
 }
+
+func TestErrorLevels(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+disableKinds = ["taxonomy", "term", "RSS", "sitemap"]
+[errorLevels]
+missingLayout = "%s"
+refNotFound = "warning"
+missingTranslation = "warning"
+rawHTMLOmitted = "warning"
+unknownShortcode = "warning"
+-- i18n/en.toml --
+hello = "Hello"
+-- content/s/p1.md --
+---
+title: p1
+---
+Before.
+{{< nosuch >}}
+{{< nosuchinner >}}Inner{{< /nosuchinner >}}
+
+<div>raw</div>
+
+[Ref]({{< ref "nosuchpage" >}})
+After.
+-- layouts/index.html --
+Home.
+-- layouts/_default/single.html --
+{{ .Content }}|{{ i18n "hello" }}|{{ i18n "nosuchkey" }}|
+`
+
+	t.Run("Warning", func(t *testing.T) {
+		b := NewIntegrationTestBuilder(
+			IntegrationTestConfig{
+				T:           t,
+				TxtarString: fmt.Sprintf(files, "ignore"),
+			},
+		).Build()
+
+		content := b.FileContent("public/s/p1/index.html")
+		b.Assert(content, qt.Contains, "Before.")
+		b.Assert(content, qt.Contains, "After.")
+		b.Assert(content, qt.Contains, "|Hello||")
+		b.Assert(content, qt.Not(qt.Contains), "Inner")
+
+		b.AssertLogContains(`WARN`)
+		b.AssertLogContains(`template for shortcode "nosuch" not found in page "/content/s/p1.md"`)
+		b.AssertLogContains(`template for shortcode "nosuchinner" not found`)
+		b.AssertLogContains(`Raw HTML omitted in "/content/s/p1.md"`)
+		b.AssertLogContains(`REF_NOT_FOUND: Ref "nosuchpage"`)
+		b.AssertLogContains(`Missing translation for language "en" and ID "nosuchkey"`)
+		b.Assert(b.logBuff.String(), qt.Not(qt.Contains), "found no layout file")
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		b, err := NewIntegrationTestBuilder(
+			IntegrationTestConfig{
+				T:           t,
+				TxtarString: fmt.Sprintf(files, "error"),
+			},
+		).BuildE()
+
+		b.Assert(err, qt.ErrorMatches, `logged 1 error\(s\)`)
+		b.AssertLogContains(`ERROR`)
+		b.AssertLogContains(`found no layout file for "HTML" for kind "section"`)
+	})
+}
+
+func TestContentFilter(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+contentFilter = ["params.internal != true", "params.audience != 'beta'"]
+-- content/docs/p1.md --
+---
+title: "P1"
+---
+-- content/docs/p2.md --
+---
+title: "P2"
+internal: true
+---
+-- content/docs/p3.md --
+---
+title: "P3"
+audience: beta
+---
+-- content/internal/_index.md --
+---
+title: "Internal"
+cascade:
+  internal: true
+---
+-- content/internal/p4.md --
+---
+title: "P4"
+---
+-- layouts/index.html --
+{{ range site.RegularPages }}{{ .Title }}|{{ end }}
+-- layouts/_default/list.html --
+List.
+-- layouts/_default/single.html --
+Single.
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html", "P1|\n")
+	b.AssertDestinationExists("docs/p1/index.html", true)
+	b.AssertDestinationExists("docs/p2/index.html", false)
+	b.AssertDestinationExists("docs/p3/index.html", false)
+	b.AssertDestinationExists("internal/index.html", false)
+	b.AssertDestinationExists("internal/p4/index.html", false)
+}
@@ -185,3 +185,61 @@ Do not go gentle into that good night.
 	th.assertFileContent(filepath.Join("public", "ss1", "index.html"), "P1|URL: /ss1/|Next: /ss1/page/2/")
 	th.assertFileContent(filepath.Join("public", "ss1", "page", "2", "index.html"), "P2|URL: /ss1/page/2/|Next: /ss1/page/3/")
 }
+
+func TestURLMode(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap"]
+urlMode = "%s"
+-- content/s1/p1.md --
+---
+title: p1
+---
+-- layouts/_default/single.html --
+<html><head><title>{{ .Title }}</title></head><body><a href="/s1/">S1</a>|<a href="//cdn.example.org/a.js">CDN</a>|<img srcset="/a.jpg 1x, //cdn.example.org/b.jpg 2x"></body></html>
+-- layouts/_default/list.html --
+<html><head><link rel="canonical" href="https://example.com/"></head><body>List</body></html>
+`
+
+	build := func(t *testing.T, mode string) *IntegrationTestBuilder {
+		return NewIntegrationTestBuilder(
+			IntegrationTestConfig{
+				T:           t,
+				TxtarString: fmt.Sprintf(files, mode),
+			},
+		).Build()
+	}
+
+	t.Run("absolute", func(t *testing.T) {
+		b := build(t, "absolute")
+		b.AssertFileContent("public/s1/p1/index.html",
+			`<a href="https://example.org/s1/">S1</a>`,
+			`<a href="//cdn.example.org/a.js">CDN</a>`,
+			`<img srcset="https://example.org/a.jpg 1x, //cdn.example.org/b.jpg 2x">`,
+		)
+		b.Assert(b.FileContent("public/s1/p1/index.html"), qt.Not(qt.Contains), "canonical")
+	})
+
+	t.Run("relative", func(t *testing.T) {
+		b := build(t, "relative")
+		b.AssertFileContent("public/s1/p1/index.html",
+			`<a href="../../s1/">S1</a>`,
+			`<a href="//cdn.example.org/a.js">CDN</a>`,
+			`<img srcset="../../a.jpg 1x, //cdn.example.org/b.jpg 2x">`,
+		)
+	})
+
+	t.Run("canonical", func(t *testing.T) {
+		b := build(t, "canonical")
+		b.AssertFileContent("public/s1/p1/index.html",
+			"<head>\n\t<link rel=\"canonical\" href=\"https://example.org/s1/p1/\" /><title>p1</title>",
+			`<a href="/s1/">S1</a>`,
+			`<img srcset="/a.jpg 1x, //cdn.example.org/b.jpg 2x">`,
+		)
+		// Keep the canonical link set in the template.
+		b.AssertFileContent("public/index.html", `/><link rel="canonical" href="https://example.com/"></head>`)
+	})
+}
@@ -87,9 +87,10 @@ func doTestSitemapOutput(t *testing.T, internal bool) {
 
 func TestParseSitemap(t *testing.T) {
 	t.Parallel()
-	expected := config.Sitemap{Priority: 3.0, Filename: "doo.xml", ChangeFreq: "3"}
+	expected := config.Sitemap{Priority: 3.0, Filename: "doo.xml", ChangeFreq: "3", Disable: true}
 	input := map[string]any{
 		"changefreq": "3",
+		"disable":    true,
 		"priority":   3.0,
 		"filename":   "doo.xml",
 		"unknown":    "ignore",
@@ -118,3 +119,72 @@ outputs: [ "html", "amp" ]
 	// Should link to the HTML version.
 	b.AssertFileContent("public/sitemap.xml", " <loc>http://example.com/blog/html-amp/</loc>")
 }
+
+func TestSitemapCascadeAndExclusions(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term"]
+paginate = 1
+[outputFormats.json]
+isPlainText = true
+-- content/blog/_index.md --
+---
+title: Blog
+cascade:
+  sitemap:
+    changefreq: weekly
+    priority: 0.3
+---
+-- content/blog/p1.md --
+---
+title: P1
+---
+-- content/blog/p2.md --
+---
+title: P2
+sitemap:
+  priority: 0.8
+---
+-- content/blog/p3.md --
+---
+title: P3
+sitemap:
+  disable: true
+---
+-- content/blog/p4.md --
+---
+title: P4
+outputs: ["json"]
+---
+-- layouts/_default/list.html --
+{{ range .Paginator.Pages }}{{ .Title }}|{{ end }}
+-- layouts/_default/single.html --
+{{ .Title }}
+-- layouts/_default/single.json --
+{{ .Title }}
+-- layouts/sitemap.xml --
+{{ range .Data.Pages }}{{ .RelPermalink }}|{{ .Sitemap.ChangeFreq }}|{{ .Sitemap.Priority }}
+{{ end }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/sitemap.xml",
+		"/blog/p1/|weekly|0.3",
+		"/blog/p2/|weekly|0.8",
+	)
+	b.AssertFileContent("public/blog/page/2/index.html", "|")
+
+	content := b.FileContent("public/sitemap.xml")
+	b.Assert(content, qt.Not(qt.Contains), "/blog/p3/")
+	b.Assert(content, qt.Not(qt.Contains), "/blog/p4/")
+	b.Assert(content, qt.Not(qt.Contains), "/page/")
+}
@@ -15,10 +15,12 @@ package hugolib
 
 import (
 	"fmt"
+	"math"
 	"sort"
 
 	"github.com/gohugoio/hugo/compare"
 	"github.com/gohugoio/hugo/langs"
+	"github.com/spf13/cast"
 
 	"github.com/gohugoio/hugo/resources/page"
 )
@@ -69,6 +71,76 @@ func (i Taxonomy) add(key string, w page.WeightedPage) {
 	i[key] = append(i[key], w)
 }
 
+// Stats returns statistics about the number of pages per term in this taxonomy.
+func (i Taxonomy) Stats() TaxonomyStats {
+	counts := make([]int, 0, len(i))
+	var stats TaxonomyStats
+	for _, v := range i {
+		counts = append(counts, len(v))
+		stats.Pages += len(v)
+	}
+	sort.Ints(counts)
+
+	stats.Terms = len(counts)
+	stats.counts = counts
+	if len(counts) > 0 {
+		stats.MinPages = counts[0]
+		stats.MaxPages = counts[len(counts)-1]
+		stats.MeanPages = float64(stats.Pages) / float64(len(counts))
+	}
+
+	return stats
+}
+
+// TaxonomyStats holds statistics about the number of pages per term in a
+// taxonomy, e.g. to decide how to present a long list of tags.
+type TaxonomyStats struct {
+	// The number of terms.
+	Terms int
+
+	// The number of pages over all terms, counting a page once per term.
+	Pages int
+
+	MinPages  int
+	MaxPages  int
+	MeanPages float64
+
+	// Sorted.
+	counts []int
+}
+
+// Percentile returns the number of pages per term at percentile p (0-100)
+// using the nearest-rank method, e.g. 90 returns the number of pages that
+// 90% of the terms have or fewer.
+func (s TaxonomyStats) Percentile(p any) (int, error) {
+	pf, err := cast.ToFloat64E(p)
+	if err != nil {
+		return 0, err
+	}
+	if pf < 0 || pf > 100 {
+		return 0, fmt.Errorf("percentile must be between 0 and 100, got %v", p)
+	}
+	if len(s.counts) == 0 {
+		return 0, nil
+	}
+
+	rank := int(math.Ceil(pf / 100 * float64(len(s.counts))))
+	if rank < 1 {
+		rank = 1
+	}
+
+	return s.counts[rank-1], nil
+}
+
+// TermCount returns the number of terms in all taxonomies.
+func (tl TaxonomyList) TermCount() int {
+	var count int
+	for _, t := range tl {
+		count += len(t)
+	}
+	return count
+}
+
 // TaxonomyArray returns an ordered taxonomy with a non defined order.
 func (i Taxonomy) TaxonomyArray() OrderedTaxonomy {
 	ies := make([]OrderedTaxonomyEntry, len(i))
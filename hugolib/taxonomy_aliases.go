@@ -0,0 +1,148 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/hugofs"
+	"github.com/gohugoio/hugo/parser/pageparser"
+	"github.com/gohugoio/hugo/resources/page"
+	"github.com/spf13/cast"
+)
+
+// The front matter key in a term page listing the aliases of that term.
+const termAliasesKey = "termAliases"
+
+// termAliasesConfig is the termAliases section of the site config, the
+// aliases of a term by taxonomy and term, e.g. tags => go => [golang].
+type termAliasesConfig map[string]map[string][]string
+
+func decodeTermAliasesConfig(v any) (termAliasesConfig, error) {
+	c := make(termAliasesConfig)
+	if v == nil {
+		return c, nil
+	}
+
+	m, err := maps.ToStringMapE(v)
+	if err != nil {
+		return c, err
+	}
+
+	for plural, vv := range m {
+		terms, err := maps.ToStringMapE(vv)
+		if err != nil {
+			return c, fmt.Errorf("taxonomy %q: %w", plural, err)
+		}
+		c[plural] = make(map[string][]string)
+		for term, vvv := range terms {
+			aliases, err := cast.ToStringSliceE(vvv)
+			if err != nil {
+				return c, fmt.Errorf("term %q in taxonomy %q: %w", term, plural, err)
+			}
+			c[plural][term] = aliases
+		}
+	}
+
+	return c, nil
+}
+
+// termAliases maps the key of an alias term to the key of its canonical term
+// by taxonomy, e.g. tags => golang => go.
+type termAliases map[string]map[string]string
+
+// aliasesOf returns the sorted keys of the aliases of the given term.
+func (t termAliases) aliasesOf(plural, termKey string) []string {
+	var aliases []string
+	for alias, canonical := range t[plural] {
+		if canonical == termKey {
+			aliases = append(aliases, alias)
+		}
+	}
+	sort.Strings(aliases)
+	return aliases
+}
+
+// collectTermAliases collects the term aliases in the site config and in the
+// front matter of the term pages. It must be called before the pages are
+// attached to their terms.
+func (m *pageMap) collectTermAliases() error {
+	aliases := make(termAliases)
+
+	add := func(plural, term string, aliasTerms []string) {
+		termKey := m.s.getTaxonomyKey(term)
+		for _, alias := range aliasTerms {
+			aliasKey := m.s.getTaxonomyKey(alias)
+			if aliasKey == termKey {
+				continue
+			}
+			if aliases[plural] == nil {
+				aliases[plural] = make(map[string]string)
+			}
+			aliases[plural][aliasKey] = termKey
+		}
+	}
+
+	for plural, terms := range m.s.siteCfg.termAliases {
+		for term, aliasTerms := range terms {
+			add(plural, term, aliasTerms)
+		}
+	}
+
+	var err error
+	m.taxonomies.Walk(func(s string, v any) bool {
+		n := v.(*contentNode)
+		if n.fi == nil || n.viewInfo == nil || n.viewInfo.kind() != page.KindTerm {
+			return false
+		}
+		var aliasTerms []string
+		aliasTerms, err = readTermAliases(n.fi)
+		if err != nil {
+			return true
+		}
+		add(n.viewInfo.name.plural, n.viewInfo.termKey, aliasTerms)
+		return false
+	})
+
+	m.termAliases = aliases
+
+	return err
+}
+
+// readTermAliases reads the term aliases from the front matter of the term
+// page in fi.
+func readTermAliases(fi hugofs.FileMetaInfo) ([]string, error) {
+	meta := fi.Meta()
+	f, err := meta.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	cf, err := pageparser.ParseFrontMatterAndContent(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s from %q: %w", termAliasesKey, meta.Filename, err)
+	}
+
+	for k, v := range cf.FrontMatter {
+		if strings.EqualFold(k, termAliasesKey) {
+			return cast.ToStringSliceE(v)
+		}
+	}
+
+	return nil, nil
+}
@@ -694,3 +694,122 @@ abcdefgs: {{ template "print-page" $abcdefgs }}|IsAncestor: {{ $abcdefgs.IsAnces
     abcdefgs: /abcdefgs/|Abcdefgs|taxonomy|Parent: /|CurrentSection: /|FirstSection: /|IsAncestor: true|IsDescendant: false
 `)
 }
+
+func TestTaxonomyStats(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["RSS", "sitemap", "robotsTXT", "404", "section"]
+-- content/p1.md --
+---
+title: P1
+tags: [a, b, c, d]
+---
+-- content/p2.md --
+---
+title: P2
+tags: [a, b]
+---
+-- content/p3.md --
+---
+title: P3
+tags: [a]
+---
+-- content/p4.md --
+---
+title: P4
+tags: [a]
+categories: [x]
+---
+-- layouts/index.html --
+{{ with site.Taxonomies.tags.Stats }}Tags: Terms: {{ .Terms }}|Pages: {{ .Pages }}|Min: {{ .MinPages }}|Max: {{ .MaxPages }}|Mean: {{ .MeanPages }}|P50: {{ .Percentile 50 }}|P75: {{ .Percentile 75 }}|P100: {{ .Percentile 100 }}|{{ end }}
+{{ with site.Taxonomies.categories.Stats }}Categories: Terms: {{ .Terms }}|P90: {{ .Percentile 90 }}|{{ end }}
+-- layouts/_default/single.html --
+Single.
+-- layouts/_default/list.html --
+List.
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html",
+		"Tags: Terms: 4|Pages: 8|Min: 1|Max: 4|Mean: 2|P50: 1|P75: 2|P100: 4|",
+		"Categories: Terms: 1|P90: 1|",
+	)
+
+	b.Assert(b.H.Sites[0].PathSpec.ProcessingStats.TaxonomyTerms, qt.Equals, uint64(5))
+
+	_, err := b.H.Sites[0].Taxonomies()["tags"].Stats().Percentile(101)
+	b.Assert(err, qt.IsNotNil)
+
+	n, err := Taxonomy{}.Stats().Percentile(90)
+	b.Assert(err, qt.IsNil)
+	b.Assert(n, qt.Equals, 0)
+}
+
+func TestTaxonomyTermAliases(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/"
+disableKinds = ["RSS", "sitemap", "robotsTXT", "404"]
+[taxonomies]
+tag = "tags"
+category = "categories"
+[termAliases.tags]
+go = ["golang", "Go Lang"]
+-- content/p1.md --
+---
+title: "P1"
+tags: ["go"]
+---
+-- content/p2.md --
+---
+title: "P2"
+tags: ["golang"]
+categories: ["hugo"]
+---
+-- content/p3.md --
+---
+title: "P3"
+tags: ["Go Lang", "go"]
+categories: ["gohugo"]
+---
+-- content/categories/hugo/_index.md --
+---
+title: "Hugo"
+termAliases: ["gohugo"]
+---
+-- layouts/_default/list.html --
+{{ .Title }}|{{ range .Pages }}{{ .Title }}|{{ end }}
+-- layouts/_default/single.html --
+{{ range .GetTerms "tags" }}{{ .LinkTitle }}:{{ .RelPermalink }}|{{ end }}
+-- layouts/_default/terms.html --
+{{ range .Data.Terms.Alphabetical }}{{ .Page.Title }}:{{ .Count }}|{{ end }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/tags/go/index.html", "go|P1|P2|P3|")
+	b.AssertFileContent("public/tags/index.html", "go:3|")
+	b.AssertFileContent("public/p2/index.html", "go:/tags/go/|")
+	b.AssertFileContent("public/p3/index.html", "go:/tags/go/|")
+	b.AssertFileContent("public/tags/golang/index.html", `<link rel="canonical" href="https://example.org/tags/go/">`)
+	b.AssertFileContent("public/tags/go-lang/index.html", `<link rel="canonical" href="https://example.org/tags/go/">`)
+
+	b.AssertFileContent("public/categories/hugo/index.html", "Hugo|P2|P3|")
+	b.AssertFileContent("public/categories/gohugo/index.html", `<link rel="canonical" href="https://example.org/categories/hugo/">`)
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/spf13/cast"
 
+	"github.com/gohugoio/hugo/common/constants"
 	"github.com/gohugoio/hugo/common/hreflect"
 	"github.com/gohugoio/hugo/common/loggers"
 	"github.com/gohugoio/hugo/config"
@@ -37,11 +38,12 @@ type Translator struct {
 	translateFuncs map[string]translateFunc
 	cfg            config.Provider
 	logger         loggers.Logger
+	errorLevels    loggers.ErrorLevels
 }
 
 // NewTranslator creates a new Translator for the given language bundle and configuration.
-func NewTranslator(b *i18n.Bundle, cfg config.Provider, logger loggers.Logger) Translator {
-	t := Translator{cfg: cfg, logger: logger, translateFuncs: make(map[string]translateFunc)}
+func NewTranslator(b *i18n.Bundle, cfg config.Provider, logger loggers.Logger, errorLevels loggers.ErrorLevels) Translator {
+	t := Translator{cfg: cfg, logger: logger, errorLevels: errorLevels, translateFuncs: make(map[string]translateFunc)}
 	t.initFuncs(b)
 	return t
 }
@@ -65,6 +67,7 @@ func (t Translator) Func(lang string) translateFunc {
 
 func (t Translator) initFuncs(bndl *i18n.Bundle) {
 	enableMissingTranslationPlaceholders := t.cfg.GetBool("enableMissingTranslationPlaceholders")
+	missingTranslationLogger := t.errorLevels.Level(constants.ErrClassMissingTranslation, loggers.ErrorLevelIgnore).Logger(t.logger)
 	for _, lang := range bndl.LanguageTags() {
 		currentLang := lang
 		currentLangStr := currentLang.String()
@@ -114,6 +117,8 @@ func (t Translator) initFuncs(bndl *i18n.Bundle) {
 				i18nWarningLogger.Printf("i18n|MISSING_TRANSLATION|%s|%s", currentLangStr, translationID)
 			}
 
+			missingTranslationLogger.Printf("Missing translation for language %q and ID %q", currentLangStr, translationID)
+
 			if enableMissingTranslationPlaceholders {
 				return "[i18n] " + translationID
 			}
@@ -71,7 +71,7 @@ func (tp *TranslationProvider) Update(d *deps.Deps) error {
 		}
 	}
 
-	tp.t = NewTranslator(bundle, d.Cfg, d.Log)
+	tp.t = NewTranslator(bundle, d.Cfg, d.Log, d.ErrorLevels)
 
 	d.Translate = tp.t.Func(d.Language.Lang)
 
@@ -91,6 +91,67 @@ type HeadingRenderer interface {
 	identity.Provider
 }
 
+// BlockquoteContext contains accessors to all attributes that a
+// BlockquoteRenderer can use to render a blockquote.
+type BlockquoteContext interface {
+	// Page is the page containing the blockquote.
+	Page() any
+
+	// Type is "alert" for GitHub style alerts, e.g. starting with [!NOTE],
+	// else "regular".
+	Type() string
+
+	// AlertType is the lower case alert type, e.g. "note" or "warning".
+	// It's empty for regular blockquotes.
+	AlertType() string
+
+	// Text is the rendered (HTML) blockquote content, without the alert marker.
+	Text() hstring.RenderedString
+
+	// Attributes (e.g. CSS classes)
+	AttributesProvider
+}
+
+// BlockquoteRenderer describes a uniquely identifiable rendering hook.
+type BlockquoteRenderer interface {
+	RenderBlockquote(w hugio.FlexiWriter, ctx BlockquoteContext) error
+	identity.Provider
+}
+
+// TableContext contains accessors to all attributes that a TableRenderer
+// can use to render a table.
+type TableContext interface {
+	// Page is the page containing the table.
+	Page() any
+
+	// THead is the header rows.
+	THead() []TableRow
+
+	// TBody is the body rows.
+	TBody() []TableRow
+
+	// Attributes (e.g. CSS classes)
+	AttributesProvider
+}
+
+// TableRow is a row of table cells.
+type TableRow []TableCell
+
+// TableCell is a table cell.
+type TableCell struct {
+	// The rendered (HTML) cell content.
+	Text hstring.RenderedString
+
+	// One of "left", "center" or "right", empty if not set.
+	Alignment string
+}
+
+// TableRenderer describes a uniquely identifiable rendering hook.
+type TableRenderer interface {
+	RenderTable(w hugio.FlexiWriter, ctx TableContext) error
+	identity.Provider
+}
+
 // ElementPositionResolver provides a way to resolve the start Position
 // of a markdown element in the original source document.
 // This may be both slow and approximate, so should only be
@@ -106,6 +167,8 @@ const (
 	ImageRendererType
 	HeadingRendererType
 	CodeBlockRendererType
+	BlockquoteRendererType
+	TableRendererType
 )
 
 type GetRendererFunc func(t RendererType, id any) any
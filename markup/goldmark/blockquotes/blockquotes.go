@@ -0,0 +1,169 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package blockquotes provides the render hook support for blockquotes,
+// including GitHub style alerts, e.g. > [!NOTE].
+package blockquotes
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/types/hstring"
+	"github.com/gohugoio/hugo/markup/converter/hooks"
+	"github.com/gohugoio/hugo/markup/goldmark/internal/render"
+	"github.com/gohugoio/hugo/markup/internal/attributes"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/util"
+)
+
+const (
+	typeRegular = "regular"
+	typeAlert   = "alert"
+)
+
+type (
+	blockquotesExtension struct{}
+	htmlRenderer         struct{}
+)
+
+func New() goldmark.Extender {
+	return &blockquotesExtension{}
+}
+
+func (e *blockquotesExtension) Extend(m goldmark.Markdown) {
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(newHTMLRenderer(), 100),
+	))
+}
+
+func newHTMLRenderer() renderer.NodeRenderer {
+	return &htmlRenderer{}
+}
+
+func (r *htmlRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindBlockquote, r.renderBlockquote)
+}
+
+func (r *htmlRenderer) renderBlockquote(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	n := node.(*ast.Blockquote)
+	var br hooks.BlockquoteRenderer
+
+	ctx, ok := w.(*render.Context)
+	if ok {
+		h := ctx.RenderContext().GetRenderer(hooks.BlockquoteRendererType, nil)
+		ok = h != nil
+		if ok {
+			br = h.(hooks.BlockquoteRenderer)
+		}
+	}
+
+	if !ok {
+		return r.renderBlockquoteDefault(w, n, entering)
+	}
+
+	if entering {
+		// Store the current pos so we can capture the rendered text.
+		ctx.PushPos(ctx.Buffer.Len())
+		return ast.WalkContinue, nil
+	}
+
+	pos := ctx.PopPos()
+	text := string(ctx.Buffer.Bytes()[pos:])
+	ctx.Buffer.Truncate(pos)
+
+	bqctx := &blockquoteContext{
+		page:             ctx.DocumentContext().Document,
+		typ:              typeRegular,
+		AttributesHolder: attributes.New(n.Attributes(), attributes.AttributesOwnerGeneral),
+	}
+
+	if alertType := alertTypeOf(n, src); alertType != "" {
+		bqctx.typ = typeAlert
+		bqctx.alertType = alertType
+		text = alertMarkerRe.ReplaceAllString(text, "<p>")
+		text = strings.TrimLeft(strings.TrimPrefix(text, "<p></p>"), "\n")
+	}
+
+	bqctx.text = hstring.RenderedString(text)
+
+	err := br.RenderBlockquote(w, bqctx)
+
+	ctx.AddIdentity(br)
+
+	return ast.WalkContinue, err
+}
+
+// Fall back to the default Goldmark render funcs. Method below borrowed from:
+// https://github.com/yuin/goldmark/blob/b611cd333a492416b56aa8d94b04a67bf0096ab2/renderer/html/html.go#L257
+func (r *htmlRenderer) renderBlockquoteDefault(w util.BufWriter, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		if n.Attributes() != nil {
+			_, _ = w.WriteString("<blockquote")
+			html.RenderAttributes(w, n, html.BlockquoteAttributeFilter)
+			_ = w.WriteByte('>')
+		} else {
+			_, _ = w.WriteString("<blockquote>\n")
+		}
+	} else {
+		_, _ = w.WriteString("</blockquote>\n")
+	}
+	return ast.WalkContinue, nil
+}
+
+var (
+	alertLineRe   = regexp.MustCompile(`^\[!([a-zA-Z]+)\]$`)
+	alertMarkerRe = regexp.MustCompile(`^\s*<p>\[![a-zA-Z]+\]\s*`)
+)
+
+// alertTypeOf returns the lower case alert type if the first line of the
+// blockquote is an alert marker, e.g. [!NOTE], else an empty string.
+func alertTypeOf(n *ast.Blockquote, src []byte) string {
+	p, ok := n.FirstChild().(*ast.Paragraph)
+	if !ok || p.Lines().Len() == 0 {
+		return ""
+	}
+	line := p.Lines().At(0)
+	m := alertLineRe.FindSubmatch(util.TrimRightSpace(line.Value(src)))
+	if m == nil {
+		return ""
+	}
+	return strings.ToLower(string(m[1]))
+}
+
+type blockquoteContext struct {
+	page      any
+	typ       string
+	alertType string
+	text      hstring.RenderedString
+	*attributes.AttributesHolder
+}
+
+func (c *blockquoteContext) Page() any {
+	return c.page
+}
+
+func (c *blockquoteContext) Type() string {
+	return c.typ
+}
+
+func (c *blockquoteContext) AlertType() string {
+	return c.alertType
+}
+
+func (c *blockquoteContext) Text() hstring.RenderedString {
+	return c.text
+}
@@ -0,0 +1,77 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockquotes_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestBlockquoteHook(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+-- layouts/_default/_markup/render-blockquote.html --
+Type: {{ .Type }}|AlertType: {{ .AlertType }}|Text: {{ .Text | safeHTML }}|Page: {{ .Page.Title }}|
+-- layouts/_default/single.html --
+{{ .Content }}
+-- content/p1.md --
+---
+title: "p1"
+---
+
+> A regular blockquote.
+
+> [!NOTE]
+> Useful information.
+
+> [!Warning]
+>
+> **Be careful.**
+-- content/p2.md --
+---
+title: "p2"
+---
+
+> No hook here.
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		"Type: regular|AlertType: |Text: <p>A regular blockquote.</p>\n|Page: p1|",
+		"Type: alert|AlertType: note|Text: <p>Useful information.</p>\n|Page: p1|",
+		"Type: alert|AlertType: warning|Text: <p><strong>Be careful.</strong></p>\n|Page: p1|",
+	)
+
+	b = hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.Replace(files, "render-blockquote.html", "render-foo.html", 1),
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		"<blockquote>\n<p>A regular blockquote.</p>\n</blockquote>",
+		"<blockquote>\n<p>[!NOTE]\nUseful information.</p>\n</blockquote>",
+	)
+}
@@ -17,9 +17,11 @@ package goldmark
 import (
 	"bytes"
 
+	"github.com/gohugoio/hugo/markup/goldmark/blockquotes"
 	"github.com/gohugoio/hugo/markup/goldmark/codeblocks"
 	"github.com/gohugoio/hugo/markup/goldmark/internal/extensions/attributes"
 	"github.com/gohugoio/hugo/markup/goldmark/internal/render"
+	"github.com/gohugoio/hugo/markup/goldmark/tables"
 
 	"github.com/gohugoio/hugo/identity"
 
@@ -88,6 +90,7 @@ func newMarkdown(pcfg converter.ProviderConfig) goldmark.Markdown {
 		extensions = []goldmark.Extender{
 			newLinks(cfg),
 			newTocExtension(rendererOptions),
+			blockquotes.New(),
 		}
 		parserOptions []parser.Option
 	)
@@ -97,7 +100,7 @@ func newMarkdown(pcfg converter.ProviderConfig) goldmark.Markdown {
 	}
 
 	if cfg.Extensions.Table {
-		extensions = append(extensions, extension.Table)
+		extensions = append(extensions, extension.Table, tables.New())
 	}
 
 	if cfg.Extensions.Strikethrough {
@@ -42,9 +42,36 @@ func (b *BufWriter) Flush() error {
 type Context struct {
 	*BufWriter
 	positions []int
+	values    map[any][]any
 	ContextData
 }
 
+// PushValue pushes v onto the stack for key k.
+func (ctx *Context) PushValue(k, v any) {
+	if ctx.values == nil {
+		ctx.values = make(map[any][]any)
+	}
+	ctx.values[k] = append(ctx.values[k], v)
+}
+
+// PopValue removes and returns the top value of the stack for key k, nil if empty.
+func (ctx *Context) PopValue(k any) any {
+	v := ctx.PeekValue(k)
+	if v != nil {
+		ctx.values[k] = ctx.values[k][:len(ctx.values[k])-1]
+	}
+	return v
+}
+
+// PeekValue returns the top value of the stack for key k, nil if empty.
+func (ctx *Context) PeekValue(k any) any {
+	values := ctx.values[k]
+	if len(values) == 0 {
+		return nil
+	}
+	return values[len(values)-1]
+}
+
 func (ctx *Context) PushPos(n int) {
 	ctx.positions = append(ctx.positions, n)
 }
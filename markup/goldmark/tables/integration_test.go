@@ -0,0 +1,76 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tables_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestTableHook(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+-- layouts/_default/_markup/render-table.html --
+Page: {{ .Page.Title }}|
+{{- range .THead }}
+THead: {{ range . }}{{ .Text | safeHTML }}:{{ .Alignment }}|{{ end }}
+{{- end }}
+{{- range .TBody }}
+TBody: {{ range . }}{{ .Text | safeHTML }}:{{ .Alignment }}|{{ end }}
+{{- end }}
+-- layouts/_default/single.html --
+{{ .Content }}
+-- content/p1.md --
+---
+title: "p1"
+---
+
+| Item | In Stock | Price |
+| :---- | :------: | ----: |
+| **Python** Hat | True | 23.99 |
+| SQL Hat | True | 23.99 |
+| Codecademy Tee | False |
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		"Page: p1|",
+		"THead: Item:left|In Stock:center|Price:right|",
+		"TBody: <strong>Python</strong> Hat:left|True:center|23.99:right|",
+		"TBody: SQL Hat:left|True:center|23.99:right|",
+		"TBody: Codecademy Tee:left|False:center|:right|",
+	)
+
+	b = hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.Replace(files, "render-table.html", "render-foo.html", 1),
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		"<table>\n<thead>\n<tr>\n<th style=\"text-align:left\">Item</th>",
+		"<td style=\"text-align:left\"><strong>Python</strong> Hat</td>",
+	)
+}
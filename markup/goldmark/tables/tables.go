@@ -0,0 +1,213 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tables provides the render hook support for tables.
+// It must be used together with Goldmark's table extension.
+package tables
+
+import (
+	"github.com/gohugoio/hugo/common/types/hstring"
+	"github.com/gohugoio/hugo/markup/converter/hooks"
+	"github.com/gohugoio/hugo/markup/goldmark/internal/render"
+	"github.com/gohugoio/hugo/markup/internal/attributes"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+type (
+	tablesExtension struct{}
+	htmlRenderer    struct {
+		// The Goldmark table renderer, used when there is no render hook.
+		defaultRenderer renderer.NodeRenderer
+		defaultFuncs    map[ast.NodeKind]renderer.NodeRendererFunc
+	}
+)
+
+// The key for the current table in the render context.
+type tableKey struct{}
+
+type table struct {
+	alignments []east.Alignment
+	th         []hooks.TableRow
+	tb         []hooks.TableRow
+	row        hooks.TableRow
+	hr         hooks.TableRenderer
+}
+
+func New() goldmark.Extender {
+	return &tablesExtension{}
+}
+
+func (e *tablesExtension) Extend(m goldmark.Markdown) {
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(newHTMLRenderer(), 100),
+	))
+}
+
+func newHTMLRenderer() renderer.NodeRenderer {
+	r := &htmlRenderer{
+		defaultRenderer: extension.NewTableHTMLRenderer(),
+		defaultFuncs:    make(map[ast.NodeKind]renderer.NodeRendererFunc),
+	}
+	r.defaultRenderer.RegisterFuncs(r)
+	return r
+}
+
+// Register collects the default render funcs.
+func (r *htmlRenderer) Register(kind ast.NodeKind, f renderer.NodeRendererFunc) {
+	r.defaultFuncs[kind] = f
+}
+
+// SetOption implements renderer.SetOptioner.
+func (r *htmlRenderer) SetOption(name renderer.OptionName, value any) {
+	if so, ok := r.defaultRenderer.(renderer.SetOptioner); ok {
+		so.SetOption(name, value)
+	}
+}
+
+func (r *htmlRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(east.KindTable, r.renderTable)
+	reg.Register(east.KindTableHeader, r.renderTableHeader)
+	reg.Register(east.KindTableRow, r.renderTableRow)
+	reg.Register(east.KindTableCell, r.renderTableCell)
+}
+
+// current returns the table being rendered with a render hook, nil if none.
+func current(w util.BufWriter) (*render.Context, *table) {
+	ctx, ok := w.(*render.Context)
+	if !ok {
+		return nil, nil
+	}
+	t, _ := ctx.PeekValue(tableKey{}).(*table)
+	return ctx, t
+}
+
+func (r *htmlRenderer) renderTable(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	ctx, t := current(w)
+
+	if entering {
+		if ctx == nil {
+			return r.defaultFuncs[node.Kind()](w, src, node, entering)
+		}
+		h := ctx.RenderContext().GetRenderer(hooks.TableRendererType, nil)
+		if h == nil {
+			return r.defaultFuncs[node.Kind()](w, src, node, entering)
+		}
+		ctx.PushValue(tableKey{}, &table{hr: h.(hooks.TableRenderer), alignments: node.(*east.Table).Alignments})
+		return ast.WalkContinue, nil
+	}
+
+	if t == nil {
+		return r.defaultFuncs[node.Kind()](w, src, node, entering)
+	}
+
+	ctx.PopValue(tableKey{})
+
+	err := t.hr.RenderTable(
+		w,
+		&tableContext{
+			page:             ctx.DocumentContext().Document,
+			thead:            t.th,
+			tbody:            t.tb,
+			AttributesHolder: attributes.New(node.Attributes(), attributes.AttributesOwnerGeneral),
+		},
+	)
+
+	ctx.AddIdentity(t.hr)
+
+	return ast.WalkContinue, err
+}
+
+func (r *htmlRenderer) renderTableHeader(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	_, t := current(w)
+	if t == nil {
+		return r.defaultFuncs[node.Kind()](w, src, node, entering)
+	}
+
+	if entering {
+		t.row = nil
+	} else {
+		t.th = append(t.th, t.row)
+	}
+
+	return ast.WalkContinue, nil
+}
+
+func (r *htmlRenderer) renderTableRow(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	_, t := current(w)
+	if t == nil {
+		return r.defaultFuncs[node.Kind()](w, src, node, entering)
+	}
+
+	if entering {
+		t.row = nil
+	} else {
+		t.tb = append(t.tb, t.row)
+	}
+
+	return ast.WalkContinue, nil
+}
+
+func (r *htmlRenderer) renderTableCell(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	ctx, t := current(w)
+	if t == nil {
+		return r.defaultFuncs[node.Kind()](w, src, node, entering)
+	}
+
+	if entering {
+		// Store the current pos so we can capture the rendered text.
+		ctx.PushPos(ctx.Buffer.Len())
+		return ast.WalkContinue, nil
+	}
+
+	pos := ctx.PopPos()
+	text := string(ctx.Buffer.Bytes()[pos:])
+	ctx.Buffer.Truncate(pos)
+
+	// Cells added to fill up short rows have no alignment set,
+	// so use the column's.
+	var alignment string
+	if col := len(t.row); col < len(t.alignments) && t.alignments[col] != east.AlignNone {
+		alignment = t.alignments[col].String()
+	}
+
+	t.row = append(t.row, hooks.TableCell{
+		Text:      hstring.RenderedString(text),
+		Alignment: alignment,
+	})
+
+	return ast.WalkContinue, nil
+}
+
+type tableContext struct {
+	page  any
+	thead []hooks.TableRow
+	tbody []hooks.TableRow
+	*attributes.AttributesHolder
+}
+
+func (c *tableContext) Page() any {
+	return c.page
+}
+
+func (c *tableContext) THead() []hooks.TableRow {
+	return c.thead
+}
+
+func (c *tableContext) TBody() []hooks.TableRow {
+	return c.tbody
+}
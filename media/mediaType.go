@@ -269,6 +269,9 @@ var (
 	GPPType  = newMediaType("video", "3gpp", []string{"3gpp", "3gp"})
 
 	OctetType = newMediaType("application", "octet-stream", nil)
+
+	// HeadersType is used for extensionless headers files, e.g. Netlify's _headers.
+	HeadersType = newMediaType("text", "x-headers", nil)
 )
 
 // DefaultTypes is the default media types supported by Hugo.
@@ -290,6 +293,7 @@ var DefaultTypes = Types{
 	XMLType,
 	SVGType,
 	TextType,
+	HeadersType,
 	OctetType,
 	YAMLType,
 	TOMLType,
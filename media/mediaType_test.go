@@ -48,6 +48,7 @@ func TestDefaultTypes(t *testing.T) {
 		{RSSType, "application", "rss", "xml", "application/rss+xml", "application/rss+xml"},
 		{SVGType, "image", "svg", "svg", "image/svg+xml", "image/svg+xml"},
 		{TextType, "text", "plain", "txt", "text/plain", "text/plain"},
+		{HeadersType, "text", "x-headers", "", "text/x-headers", "text/x-headers"},
 		{XMLType, "application", "xml", "xml", "application/xml", "application/xml"},
 		{TOMLType, "application", "toml", "toml", "application/toml", "application/toml"},
 		{YAMLType, "application", "yaml", "yaml", "application/yaml", "application/yaml"},
@@ -63,7 +64,7 @@ func TestDefaultTypes(t *testing.T) {
 
 	}
 
-	c.Assert(len(DefaultTypes), qt.Equals, 34)
+	c.Assert(len(DefaultTypes), qt.Equals, 35)
 }
 
 func TestGetByType(t *testing.T) {
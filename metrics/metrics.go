@@ -42,10 +42,24 @@ type Provider interface {
 	// TrackValue tracks the value for diff calculations etc.
 	TrackValue(key string, value any, cached bool)
 
+	// TrackArgsValue tracks the value returned by the template key when
+	// invoked with args, used to detect templates that could be cached.
+	TrackArgsValue(key string, args, value any)
+
+	// MeasurePageSince adds a render duration for the published file key,
+	// e.g. posts/foo/index.html, to the metric store.
+	MeasurePageSince(key string, start time.Time)
+
+	// WritePageMetrics will write a summary of the slowest pages to w.
+	WritePageMetrics(w io.Writer)
+
 	// Reset clears the metric store.
 	Reset()
 }
 
+// The number of pages listed by WritePageMetrics.
+const slowestPagesCount = 10
+
 type diff struct {
 	baseline any
 	count    int
@@ -72,9 +86,12 @@ type Store struct {
 	metrics        map[string][]time.Duration
 	mu             sync.Mutex
 	diffs          map[string]*diff
+	argDiffs       map[string]map[string]*diff
 	diffmu         sync.Mutex
 	cached         map[string]int
 	cachedmu       sync.Mutex
+	pages          map[string][]time.Duration
+	pagesmu        sync.Mutex
 }
 
 // NewProvider returns a new instance of a metric store.
@@ -83,7 +100,9 @@ func NewProvider(calculateHints bool) Provider {
 		calculateHints: calculateHints,
 		metrics:        make(map[string][]time.Duration),
 		diffs:          make(map[string]*diff),
+		argDiffs:       make(map[string]map[string]*diff),
 		cached:         make(map[string]int),
+		pages:          make(map[string][]time.Duration),
 	}
 }
 
@@ -95,11 +114,16 @@ func (s *Store) Reset() {
 
 	s.diffmu.Lock()
 	s.diffs = make(map[string]*diff)
+	s.argDiffs = make(map[string]map[string]*diff)
 	s.diffmu.Unlock()
 
 	s.cachedmu.Lock()
 	s.cached = make(map[string]int)
 	s.cachedmu.Unlock()
+
+	s.pagesmu.Lock()
+	s.pages = make(map[string][]time.Duration)
+	s.pagesmu.Unlock()
 }
 
 // TrackValue tracks the value for diff calculations etc.
@@ -126,6 +150,36 @@ func (s *Store) TrackValue(key string, value any, cached bool) {
 	}
 }
 
+// TrackArgsValue tracks the value returned by the template key when
+// invoked with args. Arguments we cannot identify are ignored.
+func (s *Store) TrackArgsValue(key string, args, value any) {
+	if !s.calculateHints {
+		return
+	}
+
+	ak, ok := argsKey(args)
+	if !ok {
+		return
+	}
+
+	s.diffmu.Lock()
+	defer s.diffmu.Unlock()
+
+	m, found := s.argDiffs[key]
+	if !found {
+		m = make(map[string]*diff)
+		s.argDiffs[key] = m
+	}
+
+	d, found := m[ak]
+	if !found {
+		d = &diff{}
+		m[ak] = d
+	}
+
+	d.add(value)
+}
+
 // MeasureSince adds a measurement for key to the metric store.
 func (s *Store) MeasureSince(key string, start time.Time) {
 	s.mu.Lock()
@@ -133,6 +187,38 @@ func (s *Store) MeasureSince(key string, start time.Time) {
 	s.mu.Unlock()
 }
 
+// MeasurePageSince adds a render duration for the published file key to the metric store.
+func (s *Store) MeasurePageSince(key string, start time.Time) {
+	s.pagesmu.Lock()
+	s.pages[key] = append(s.pages[key], time.Since(start))
+	s.pagesmu.Unlock()
+}
+
+// WritePageMetrics writes a summary of the slowest pages to w.
+func (s *Store) WritePageMetrics(w io.Writer) {
+	s.pagesmu.Lock()
+
+	results := make([]result, 0, len(s.pages))
+	for k, v := range s.pages {
+		results = append(results, newResult(k, v))
+	}
+
+	s.pagesmu.Unlock()
+
+	sort.Sort(bySum(results))
+	if len(results) > slowestPagesCount {
+		results = results[:slowestPagesCount]
+	}
+
+	fmt.Fprintf(w, "  %13s  %12s  %12s  %5s  %s\n", "cumulative", "average", "maximum", "", "")
+	fmt.Fprintf(w, "  %13s  %12s  %12s  %5s  %s\n", "duration", "duration", "duration", "count", "page")
+	fmt.Fprintf(w, "  %13s  %12s  %12s  %5s  %s\n", "----------", "--------", "--------", "-----", "----")
+
+	for _, v := range results {
+		fmt.Fprintf(w, "  %13s  %12s  %12s  %5d  %s\n", v.sum, v.avg, v.max, v.count, v.key)
+	}
+}
+
 // WriteMetrics writes a summary of the metrics to w.
 func (s *Store) WriteMetrics(w io.Writer) {
 	s.mu.Lock()
@@ -141,9 +227,6 @@ func (s *Store) WriteMetrics(w io.Writer) {
 
 	var i int
 	for k, v := range s.metrics {
-		var sum time.Duration
-		var max time.Duration
-
 		diff, found := s.diffs[k]
 
 		cacheFactor := 0
@@ -151,17 +234,10 @@ func (s *Store) WriteMetrics(w io.Writer) {
 			cacheFactor = int(math.Floor(float64(diff.simSum) / float64(diff.count)))
 		}
 
-		for _, d := range v {
-			sum += d
-			if d > max {
-				max = d
-			}
-		}
-
-		avg := time.Duration(int(sum) / len(v))
-		cacheCount := s.cached[k]
-
-		results[i] = result{key: k, count: len(v), max: max, sum: sum, avg: avg, cacheCount: cacheCount, cacheFactor: cacheFactor}
+		results[i] = newResult(k, v)
+		results[i].cacheCount = s.cached[k]
+		results[i].cacheFactor = cacheFactor
+		results[i].repeated, results[i].sameForAllArgs = s.cacheCandidate(k)
 		i++
 	}
 
@@ -186,6 +262,83 @@ func (s *Store) WriteMetrics(w io.Writer) {
 			fmt.Fprintf(w, "  %13s  %12s  %12s  %5d  %s\n", v.sum, v.avg, v.max, v.count, v.key)
 		}
 	}
+
+	if s.calculateHints {
+		writeCacheSuggestions(w, results)
+	}
+}
+
+// cacheCandidate returns the number of invocations of the template key that
+// returned the same value as an earlier invocation with the same arguments.
+// It returns 0 if any two invocations with the same arguments differed.
+// sameForAllArgs is set if all the tracked invocations returned the same value.
+func (s *Store) cacheCandidate(key string) (repeated int, sameForAllArgs bool) {
+	s.diffmu.Lock()
+	defer s.diffmu.Unlock()
+
+	m, found := s.argDiffs[key]
+	if !found {
+		return 0, false
+	}
+
+	var baseline any
+	sameForAllArgs = true
+	for _, d := range m {
+		if d.simSum != d.count*100 {
+			return 0, false
+		}
+		repeated += d.count - 1
+		if baseline == nil {
+			baseline = d.baseline
+		} else if sameForAllArgs && howSimilar(baseline, d.baseline) != 100 {
+			sameForAllArgs = false
+		}
+	}
+
+	if sameForAllArgs {
+		// Cached without variants, only the first invocation would be executed.
+		var count int
+		for _, d := range m {
+			count += d.count
+		}
+		repeated = count - 1
+	}
+
+	return
+}
+
+// writeCacheSuggestions writes a list of the partials that always returned the
+// same value for the same arguments and that could be converted to partialCached,
+// along with the estimated time saving.
+func writeCacheSuggestions(w io.Writer, results []result) {
+	var candidates []result
+	for _, v := range results {
+		if v.repeated > 0 && strings.HasPrefix(v.key, "partials/") {
+			candidates = append(candidates, v)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].saving() > candidates[j].saving()
+	})
+
+	fmt.Fprintf(w, "\nThese partials returned the same output for the same arguments and may benefit from partialCached:\n\n")
+	fmt.Fprintf(w, "  %13s  %8s  %s\n", "estimated", "repeated", "")
+	fmt.Fprintf(w, "  %13s  %8s  %s\n", "saving", "count", "suggestion")
+	fmt.Fprintf(w, "  %13s  %8s  %s\n", "---------", "--------", "----------")
+
+	for _, v := range candidates {
+		name := strings.TrimPrefix(v.key, "partials/")
+		suggestion := fmt.Sprintf("{{ partialCached %q . }}", name)
+		if !v.sameForAllArgs {
+			suggestion = fmt.Sprintf("{{ partialCached %q . . }}", name)
+		}
+		fmt.Fprintf(w, "  %13s  %8d  %s\n", v.saving(), v.repeated, suggestion)
+	}
 }
 
 // A result represents the calculated results for a given metric.
@@ -197,6 +350,30 @@ type result struct {
 	sum         time.Duration
 	max         time.Duration
 	avg         time.Duration
+
+	// Number of invocations that could have been served from the cache.
+	repeated       int
+	sameForAllArgs bool
+}
+
+func newResult(key string, durations []time.Duration) result {
+	var sum time.Duration
+	var max time.Duration
+
+	for _, d := range durations {
+		sum += d
+		if d > max {
+			max = d
+		}
+	}
+
+	avg := time.Duration(int(sum) / len(durations))
+
+	return result{key: key, count: len(durations), max: max, sum: sum, avg: avg}
+}
+
+func (r result) saving() time.Duration {
+	return r.avg * time.Duration(r.repeated)
 }
 
 type bySum []result
@@ -291,3 +468,61 @@ func howSimilarStrings(a, b string) int {
 func partition(d, scale int) int {
 	return int(math.Floor((float64(d) / float64(scale)))) * scale
 }
+
+// argsKey returns a string identifying args, using the pointer address for
+// pointer values, e.g. a Page. ok is false if args cannot be identified.
+func argsKey(args any) (string, bool) {
+	var sb strings.Builder
+	if !writeArgsKey(&sb, reflect.ValueOf(args)) {
+		return "", false
+	}
+	return sb.String(), true
+}
+
+func writeArgsKey(sb *strings.Builder, v reflect.Value) bool {
+	if !v.IsValid() {
+		sb.WriteString("nil;")
+		return true
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		return writeArgsKey(sb, v.Elem())
+	case reflect.Ptr:
+		fmt.Fprintf(sb, "%s@%x;", v.Type(), v.Pointer())
+		return true
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return false
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		fmt.Fprintf(sb, "%s{", v.Type())
+		for _, k := range keys {
+			fmt.Fprintf(sb, "%q:", k.String())
+			if !writeArgsKey(sb, v.MapIndex(k)) {
+				return false
+			}
+		}
+		sb.WriteString("};")
+		return true
+	case reflect.Slice, reflect.Array:
+		fmt.Fprintf(sb, "%s[", v.Type())
+		for i := 0; i < v.Len(); i++ {
+			if !writeArgsKey(sb, v.Index(i)) {
+				return false
+			}
+		}
+		sb.WriteString("];")
+		return true
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return false
+	}
+
+	if !v.Type().Comparable() || !v.CanInterface() {
+		return false
+	}
+
+	fmt.Fprintf(sb, "%s:%#v;", v.Type(), v.Interface())
+	return true
+}
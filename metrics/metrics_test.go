@@ -14,9 +14,11 @@
 package metrics
 
 import (
+	"fmt"
 	"html/template"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gohugoio/hugo/resources/page"
 
@@ -67,3 +69,97 @@ func BenchmarkHowSimilar(b *testing.B) {
 		howSimilar(s1, s2)
 	}
 }
+
+func TestCacheSuggestions(t *testing.T) {
+	c := qt.New(t)
+
+	s := NewProvider(true)
+	p1, p2 := &testStruct{Name: "P1"}, &testStruct{Name: "P2"}
+
+	track := func(key string, args, value any) {
+		s.MeasureSince(key, time.Now().Add(-time.Millisecond))
+		s.TrackValue(key, value, false)
+		s.TrackArgsValue(key, args, value)
+	}
+
+	for i := 0; i < 3; i++ {
+		// Same output for all arguments.
+		track("partials/footer.html", p1, "Footer")
+		track("partials/footer.html", p2, "Footer")
+		// Same output for the same arguments.
+		track("partials/title.html", p1, "P1")
+		track("partials/title.html", p2, "P2")
+		track("partials/meta.html", map[string]any{"page": p1, "n": 1}, "Meta")
+		// Different output for the same arguments.
+		track("partials/random.html", p1, fmt.Sprintf("Random %d", i))
+		// Not a partial.
+		track("_default/single.html", p1, "Single")
+	}
+
+	var b strings.Builder
+	s.WriteMetrics(&b)
+	out := b.String()
+
+	c.Assert(out, qt.Contains, "may benefit from partialCached")
+	c.Assert(out, qt.Matches, `(?s).*\s5  \{\{ partialCached "footer.html" \. \}\}.*`)
+	c.Assert(out, qt.Matches, `(?s).*\s4  \{\{ partialCached "title.html" \. \. \}\}.*`)
+	c.Assert(out, qt.Matches, `(?s).*\s2  \{\{ partialCached "meta.html" \. \}\}.*`)
+	c.Assert(out, qt.Not(qt.Contains), `"random.html"`)
+	c.Assert(out, qt.Not(qt.Contains), `"_default/single.html"`)
+
+	s.Reset()
+	b.Reset()
+	s.WriteMetrics(&b)
+	c.Assert(b.String(), qt.Not(qt.Contains), "partialCached")
+}
+
+func TestArgsKey(t *testing.T) {
+	c := qt.New(t)
+
+	p1, p2 := &testStruct{Name: "A"}, &testStruct{Name: "A"}
+
+	key := func(v any) string {
+		k, ok := argsKey(v)
+		c.Assert(ok, qt.IsTrue)
+		return k
+	}
+
+	c.Assert(key(p1), qt.Equals, key(p1))
+	c.Assert(key(p1), qt.Not(qt.Equals), key(p2))
+	c.Assert(key(nil), qt.Equals, key(nil))
+	c.Assert(key("a"), qt.Not(qt.Equals), key("b"))
+	c.Assert(key(32), qt.Not(qt.Equals), key("32"))
+	c.Assert(key(map[string]any{"a": p1, "b": 1}), qt.Equals, key(map[string]any{"b": 1, "a": p1}))
+	c.Assert(key(map[string]any{"a": p1}), qt.Not(qt.Equals), key(map[string]any{"a": p2}))
+	c.Assert(key([]any{p1, "a"}), qt.Equals, key([]any{p1, "a"}))
+
+	_, ok := argsKey(func() {})
+	c.Assert(ok, qt.IsFalse)
+	_, ok = argsKey(map[int]any{1: "a"})
+	c.Assert(ok, qt.IsFalse)
+}
+
+func TestWritePageMetrics(t *testing.T) {
+	c := qt.New(t)
+
+	s := NewProvider(false)
+
+	for i := 0; i < slowestPagesCount+5; i++ {
+		s.MeasurePageSince(fmt.Sprintf("p%d/index.html", i), time.Now().Add(-time.Duration(i+1)*time.Second))
+	}
+	s.MeasurePageSince("p0/index.html", time.Now().Add(-time.Minute))
+
+	var b strings.Builder
+	s.WritePageMetrics(&b)
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+
+	c.Assert(lines, qt.HasLen, slowestPagesCount+3)
+	c.Assert(lines[3], qt.Matches, `\s+1m1\..*\s2  p0/index.html`)
+	c.Assert(lines[4], qt.Matches, `.*\s1  p14/index.html`)
+	c.Assert(b.String(), qt.Not(qt.Contains), "p4/index.html")
+
+	s.Reset()
+	b.Reset()
+	s.WritePageMetrics(&b)
+	c.Assert(b.String(), qt.Not(qt.Contains), "index.html")
+}
@@ -302,6 +302,30 @@ func (m Menu) Clone() Menu {
 	return append(Menu(nil), m...)
 }
 
+// MenuTrails returns the trail to every page in m, keyed by the page.
+// A trail is the entries from the top level of m down to the first entry,
+// in menu order, that links to the page.
+func MenuTrails(m Menu) map[Page]Menu {
+	trails := make(map[Page]Menu)
+
+	var walk func(m Menu, parents Menu)
+	walk = func(m Menu, parents Menu) {
+		for _, me := range m {
+			trail := append(parents.Clone(), me)
+			if !types.IsNil(me.Page) {
+				if _, found := trails[me.Page]; !found {
+					trails[me.Page] = trail
+				}
+			}
+			walk(me.Children, trail)
+		}
+	}
+
+	walk(m, nil)
+
+	return trails
+}
+
 func (m *MenuEntry) Title() string {
 	if m.title != "" {
 		return m.title
@@ -38,6 +38,10 @@ type MenusGetter interface {
 type MenuQueryProvider interface {
 	HasMenuCurrent(menuID string, me *MenuEntry) bool
 	IsMenuCurrent(menuID string, inme *MenuEntry) bool
+
+	// MenuTrail returns the entries from the top level of the menu with
+	// the given id down to the entry for this page, nil if not found.
+	MenuTrail(menuID string) Menu
 }
 
 func PageMenusFromPage(p Page) (PageMenus, error) {
@@ -198,6 +202,13 @@ func (pm *pageMenus) IsMenuCurrent(menuID string, inme *MenuEntry) bool {
 	return false
 }
 
+func (pm *pageMenus) MenuTrail(menuID string) Menu {
+	if types.IsNil(pm.p) {
+		return nil
+	}
+	return MenuTrails(pm.sitem.Menus()[menuID])[pm.p]
+}
+
 func (pm *pageMenus) isSameAsDescendantMenu(inme *MenuEntry, parent *MenuEntry) bool {
 	if parent.HasChildren() {
 		for _, child := range parent.Children {
@@ -228,3 +239,7 @@ func (m nopPageMenus) HasMenuCurrent(menuID string, me *MenuEntry) bool {
 func (m nopPageMenus) IsMenuCurrent(menuID string, inme *MenuEntry) bool {
 	return false
 }
+
+func (m nopPageMenus) MenuTrail(menuID string) Menu {
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"sync"
 
 	"github.com/gohugoio/hugo/helpers"
+	"github.com/gohugoio/hugo/media"
 )
 
 // These may be used as content sections with potential conflicts. Avoid that.
@@ -216,9 +217,36 @@ func resolvePageTemplate(d LayoutDescriptor, f Format) []string {
 		layouts = append(layouts, "_internal/_default/rss.xml")
 	}
 
+	if !d.RenderingHook && !d.Baseof && f.Name == SearchIndexFormat.Name {
+		// Templates for e.g. the JSON output format are not a good fit for
+		// the search index, so only use templates made for it.
+		layouts = append(filterLayouts(layouts, ".searchindex."), "_internal/_default/searchindex.json")
+	}
+
+	if !d.RenderingHook && !d.Baseof && d.isList() && f.internal && f.Name == PrintFormat.Name {
+		// The regular HTML list templates are not a good fit for the print
+		// version, so only use templates made for it, e.g. list.print.html.
+		layouts = append(filterLayouts(layouts, ".print."), "_internal/_default/print.html")
+	}
+
+	if !d.RenderingHook && !d.Baseof && f.MediaType.Type() == media.HeadersType.Type() {
+		layouts = append(layouts, "_internal/_default/headers")
+	}
+
 	return layouts
 }
 
+// filterLayouts filters layouts in place, keeping those containing s.
+func filterLayouts(layouts []string, s string) []string {
+	filtered := layouts[:0]
+	for _, l := range layouts {
+		if strings.Contains(l, s) {
+			filtered = append(filtered, l)
+		}
+	}
+	return filtered
+}
+
 func (l *layoutBuilder) resolveVariations() []string {
 	var layouts []string
 
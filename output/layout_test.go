@@ -233,6 +233,76 @@ func TestLayout(t *testing.T) {
 				"_default/list.html",
 			},
 		},
+		{
+			"Home, search index",
+			LayoutDescriptor{Kind: "home"},
+			"", SearchIndexFormat,
+			[]string{
+				"index.searchindex.json",
+				"home.searchindex.json",
+				"list.searchindex.json",
+				"_default/index.searchindex.json",
+				"_default/home.searchindex.json",
+				"_default/list.searchindex.json",
+				"_internal/_default/searchindex.json",
+			},
+		},
+		{
+			"Home, headers",
+			LayoutDescriptor{Kind: "home"},
+			"", Format{Name: "headers", MediaType: media.HeadersType, BaseName: "_headers"},
+			[]string{
+				"index.headers",
+				"home.headers",
+				"list.headers",
+				"_default/index.headers",
+				"_default/home.headers",
+				"_default/list.headers",
+				"_internal/_default/headers",
+			},
+		},
+		{
+			"Section, print",
+			LayoutDescriptor{Kind: "section", Section: "sect1"},
+			"", PrintFormat,
+			[]string{
+				"sect1/sect1.print.html",
+				"sect1/section.print.html",
+				"sect1/list.print.html",
+				"section/sect1.print.html",
+				"section/section.print.html",
+				"section/list.print.html",
+				"_default/sect1.print.html",
+				"_default/section.print.html",
+				"_default/list.print.html",
+				"_internal/_default/print.html",
+			},
+		},
+		{
+			"Section, user defined print",
+			LayoutDescriptor{Kind: "section", Section: "sect1"},
+			"", Format{Name: "print", MediaType: media.HTMLType},
+			[]string{
+				"sect1/sect1.print.html",
+				"sect1/section.print.html",
+				"sect1/list.print.html",
+				"sect1/sect1.html",
+				"sect1/section.html",
+				"sect1/list.html",
+				"section/sect1.print.html",
+				"section/section.print.html",
+				"section/list.print.html",
+				"section/sect1.html",
+				"section/section.html",
+				"section/list.html",
+				"_default/sect1.print.html",
+				"_default/section.print.html",
+				"_default/list.print.html",
+				"_default/sect1.html",
+				"_default/section.html",
+				"_default/list.html",
+			},
+		},
 		{
 			"Section, baseof",
 			LayoutDescriptor{Kind: "section", Section: "sect1", Baseof: true},
@@ -80,6 +80,11 @@ type Format struct {
 
 	// Setting this to a non-zero value will be used as the first sort criteria.
 	Weight int `json:"weight"`
+
+	// Set for built-in formats rendered with templates made for them only,
+	// see PrintFormat. A user defined format with the same name is merged
+	// with it as any other, but uses the regular layouts.
+	internal bool
 }
 
 // An ordered list of built-in output formats.
@@ -141,6 +146,29 @@ var (
 		IsPlainText: true,
 	}
 
+	// PrintFormat is a print friendly version of a branch page with the
+	// content of all its descendants in one document.
+	PrintFormat = Format{
+		Name:      "Print",
+		MediaType: media.HTMLType,
+		BaseName:  "index",
+		Path:      "print",
+		Rel:       "alternate",
+		IsHTML:    true,
+		internal:  true,
+	}
+
+	// SearchIndexFormat is a JSON index of all the regular pages in the site,
+	// to be used with client side search libraries such as Lunr.js.
+	SearchIndexFormat = Format{
+		Name:        "SearchIndex",
+		MediaType:   media.JSONType,
+		BaseName:    "searchindex",
+		IsPlainText: true,
+		NoUgly:      true,
+		Rel:         "alternate",
+	}
+
 	JSONFormat = Format{
 		Name:        "JSON",
 		MediaType:   media.JSONType,
@@ -192,9 +220,11 @@ var DefaultFormats = Formats{
 	HTMLFormat,
 	JSONFormat,
 	MarkdownFormat,
+	PrintFormat,
 	WebAppManifestFormat,
 	RobotsTxtFormat,
 	RSSFormat,
+	SearchIndexFormat,
 	SitemapFormat,
 }
 
@@ -308,6 +338,8 @@ func DecodeFormats(mediaTypes media.Types, maps ...map[string]any) (Formats, err
 			found := false
 			for i, vv := range f {
 				if strings.EqualFold(k, vv.Name) {
+					// The user owns the layouts for this format now.
+					f[i].internal = false
 					// Merge it with the existing
 					if err := decode(mediaTypes, v, &f[i]); err != nil {
 						return f, err
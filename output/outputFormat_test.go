@@ -68,7 +68,14 @@ func TestDefaultTypes(t *testing.T) {
 	c.Assert(RSSFormat.NoUgly, qt.Equals, true)
 	c.Assert(CalendarFormat.IsHTML, qt.Equals, false)
 
-	c.Assert(len(DefaultFormats), qt.Equals, 11)
+	c.Assert(PrintFormat.Path, qt.Equals, "print")
+	c.Assert(PrintFormat.IsHTML, qt.Equals, true)
+	c.Assert(PrintFormat.Permalinkable, qt.Equals, false)
+
+	c.Assert(SearchIndexFormat.BaseName, qt.Equals, "searchindex")
+	c.Assert(SearchIndexFormat.IsPlainText, qt.Equals, true)
+
+	c.Assert(len(DefaultFormats), qt.Equals, 13)
 
 }
 
@@ -229,6 +236,28 @@ func TestDecodeFormats(t *testing.T) {
 				c.Assert(xml.BaseName, qt.Equals, "myredefined")
 				c.Assert(xml.MediaType, qt.Equals, media.XMLType)
 			},
+		}, {
+			"Define print format",
+			[]map[string]any{
+				{
+					"print": map[string]any{
+						"weight": 30,
+					},
+				},
+			},
+			false,
+			func(t *testing.T, name string, f Formats) {
+				c.Assert(len(f), qt.Equals, len(DefaultFormats))
+				print, found := f.GetByName("print")
+				c.Assert(found, qt.Equals, true)
+				// Merged with the built-in format.
+				c.Assert(print.Weight, qt.Equals, 30)
+				c.Assert(print.Path, qt.Equals, "print")
+				c.Assert(print.BaseName, qt.Equals, "index")
+				c.Assert(print.IsHTML, qt.Equals, true)
+				c.Assert(print.MediaType, qt.Equals, media.HTMLType)
+				c.Assert(print.internal, qt.Equals, false)
+			},
 		},
 	}
 
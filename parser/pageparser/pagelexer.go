@@ -230,6 +230,13 @@ func (l *pageLexer) errorf(format string, args ...any) stateFunc {
 	return nil
 }
 
+// errorfAt is like errorf, but marks the error item with the given byte offset
+// so it can be mapped to a line and column in the source.
+func (l *pageLexer) errorfAt(pos int, format string, args ...any) stateFunc {
+	l.append(Item{Type: tError, Err: fmt.Errorf(format, args...), low: pos, high: pos})
+	return nil
+}
+
 func (l *pageLexer) consumeCRLF() bool {
 	var consumed bool
 	for _, r := range crLf {
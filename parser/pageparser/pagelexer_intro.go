@@ -13,9 +13,15 @@
 
 package pageparser
 
+import "unicode/utf8"
+
 func lexIntroSection(l *pageLexer) stateFunc {
 	l.summaryDivider = summaryDivider
 
+	if pos := invalidUTF8Pos(l.input); pos != -1 {
+		return l.errorfAt(pos, "invalid UTF-8 encoding (byte 0x%x); make sure the file is saved as UTF-8", l.input[pos])
+	}
+
 LOOP:
 	for {
 		r := l.next()
@@ -187,3 +193,19 @@ func (l *pageLexer) lexFrontMatterSection(tp ItemType, delimr rune, name string,
 
 	return lexMainSection
 }
+
+// invalidUTF8Pos returns the byte offset of the first invalid UTF-8
+// sequence in b, or -1 if b is valid UTF-8.
+func invalidUTF8Pos(b []byte) int {
+	if utf8.Valid(b) {
+		return -1
+	}
+	for i := 0; i < len(b); {
+		r, size := utf8.DecodeRune(b[i:])
+		if r == utf8.RuneError && size <= 1 {
+			return i
+		}
+		i += size
+	}
+	return -1
+}
@@ -70,10 +70,14 @@ var frontMatterTests = []lexerTest{
 	// Note that we keep all bytes as they are, but we need to handle CRLF
 	{"YAML front matter CRLF", "---\r\nfoo: \"bar\"\r\n---\n\nSome text.\n", []typeText{tstFrontMatterYAMLCRLF, tstSomeText, tstEOF}},
 	{"TOML front matter", "+++\nfoo = \"bar\"\n+++\n\nSome text.\n", []typeText{tstFrontMatterTOML, tstSomeText, tstEOF}},
+	{"TOML front matter CRLF", "+++\r\nfoo = \"bar\"\r\n+++\r\n\nSome text.\n", []typeText{nti(TypeFrontMatterTOML, "foo = \"bar\"\r\n"), tstSomeText, tstEOF}},
+	{"Byte order mark and front matter", "\ufeff+++\nfoo = \"bar\"\n+++\n\nSome text.\n", []typeText{nti(TypeIgnore, "\ufeff"), tstFrontMatterTOML, tstSomeText, tstEOF}},
+	{"Invalid UTF-8", "+++\nfoo = \"bar\"\n+++\n\nSome \xe6 text.\n", []typeText{nti(tError, "invalid UTF-8 encoding (byte 0xe6); make sure the file is saved as UTF-8")}},
 	{"JSON front matter", tstJSON + "\r\n\nSome text.\n", []typeText{tstFrontMatterJSON, tstSomeText, tstEOF}},
 	{"ORG front matter", tstORG + "\nSome text.\n", []typeText{tstFrontMatterORG, tstSomeText, tstEOF}},
 	{"Summary divider ORG", tstORG + "\nSome text.\n# more\nSome text.\n", []typeText{tstFrontMatterORG, tstSomeText, nti(TypeLeadSummaryDivider, "# more\n"), nti(tText, "Some text.\n"), tstEOF}},
 	{"Summary divider", "+++\nfoo = \"bar\"\n+++\n\nSome text.\n<!--more-->\nSome text.\n", []typeText{tstFrontMatterTOML, tstSomeText, tstSummaryDivider, nti(tText, "Some text.\n"), tstEOF}},
+	{"Summary divider CRLF", "+++\r\nfoo = \"bar\"\r\n+++\r\n\r\nSome text.\r\n<!--more-->\r\nSome text.\r\n", []typeText{nti(TypeFrontMatterTOML, "foo = \"bar\"\r\n"), nti(tText, "\r\nSome text.\r\n"), nti(TypeLeadSummaryDivider, "<!--more-->\r\n"), nti(tText, "Some text.\r\n"), tstEOF}},
 	{"Summary divider same line", "+++\nfoo = \"bar\"\n+++\n\nSome text.<!--more-->Some text.\n", []typeText{tstFrontMatterTOML, nti(tText, "\nSome text."), nti(TypeLeadSummaryDivider, "<!--more-->"), nti(tText, "Some text.\n"), tstEOF}},
 	// https://github.com/gohugoio/hugo/issues/5402
 	{"Summary and shortcode, no space", "+++\nfoo = \"bar\"\n+++\n\nSome text.\n<!--more-->{{< sc1 >}}\nSome text.\n", []typeText{tstFrontMatterTOML, tstSomeText, nti(TypeLeadSummaryDivider, "<!--more-->"), tstLeftNoMD, tstSC1, tstRightNoMD, tstSomeText, tstEOF}},
@@ -63,6 +63,10 @@ type Descriptor struct {
 	// If set, will replace all relative URLs with this one.
 	AbsURLPath string
 
+	// If set, a canonical link to this URL will be injected in the header
+	// if not already present. Only used for HTML type of output formats.
+	CanonicalURL string
+
 	// Enable to minify the output using the OutputFormat defined above to
 	// pick the correct minifier configuration.
 	Minify bool
@@ -177,6 +181,10 @@ func (p DestinationPublisher) createTransformerChain(f Descriptor) transform.Cha
 			transformers = append(transformers, metainject.HugoGenerator)
 		}
 
+		if f.CanonicalURL != "" {
+			transformers = append(transformers, metainject.NewCanonicalLink(f.CanonicalURL))
+		}
+
 	}
 
 	if p.min.MinifyOutput {
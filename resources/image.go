@@ -263,17 +263,11 @@ func (i *imageResource) Filter(filters ...any) (images.ImageResource, error) {
 	})
 }
 
-// Serialize image processing. The imaging library spins up its own set of Go routines,
-// so there is not much to gain from adding more load to the mix. That
-// can even have negative effect in low resource scenarios.
-// Note that this only effects the non-cached scenario. Once the processed
-// image is written to disk, everything is fast, fast fast.
-const imageProcWorkers = 1
-
-var imageProcSem = make(chan bool, imageProcWorkers)
-
 func (i *imageResource) doWithImageConfig(conf images.ImageConfig, f func(src image.Image) (image.Image, error)) (images.ImageResource, error) {
 	img, err := i.getSpec().imageCache.getOrCreate(i, conf, func() (*imageResource, image.Image, error) {
+		// Note that this only effects the non-cached scenario. Once the processed
+		// image is written to disk, everything is fast, fast fast.
+		imageProcSem := i.getSpec().ImageProcSem
 		imageProcSem <- true
 		defer func() {
 			<-imageProcSem
@@ -344,6 +338,16 @@ func (i *imageResource) decodeImageConfig(action, spec string) (images.ImageConf
 		return conf, err
 	}
 
+	if action == "fill" || action == "crop" {
+		if v, found := i.Params()["focalpoint"]; found {
+			fp, err := images.ParseFocalPoint(v)
+			if err != nil {
+				return conf, fmt.Errorf("%s: %w", i.Name(), err)
+			}
+			conf.SetFocalPoint(fp)
+		}
+	}
+
 	return conf, nil
 }
 
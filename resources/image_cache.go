@@ -19,6 +19,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/gohugoio/hugo/resources/images"
 
@@ -33,6 +34,23 @@ type imageCache struct {
 
 	mu    sync.RWMutex
 	store map[string]*resourceAdapter
+
+	// The number of images created (not read from the file cache).
+	created uint64
+}
+
+// Log progress every imageProgressInterval created images.
+const imageProgressInterval = 50
+
+type countingWriter struct {
+	io.Writer
+	n int
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.Writer.Write(p)
+	w.n += n
+	return n, err
 }
 
 func (c *imageCache) deleteIfContains(s string) {
@@ -91,6 +109,8 @@ func (c *imageCache) getOrCreate(
 	// read clones the parent to its new name and copies
 	// the content to the destinations.
 	read := func(info filecache.ItemInfo, r io.ReadSeeker) error {
+		c.pathSpec.ProcessingStats.Incr(&c.pathSpec.ProcessingStats.ImageCacheHits)
+
 		img = parent.clone(nil)
 		rp := img.getResourcePaths()
 		rp.relTargetDirFile.file = relTarget.file
@@ -132,7 +152,20 @@ func (c *imageCache) getOrCreate(
 		rp.relTargetDirFile.file = relTarget.file
 		img.setSourceFilename(info.Name)
 
-		return img.EncodeTo(conf, conv, w)
+		cw := &countingWriter{Writer: w}
+		if err = img.EncodeTo(conf, conv, cw); err != nil {
+			return
+		}
+
+		stats := c.pathSpec.ProcessingStats
+		stats.Add(&stats.ImageBytesRead, parent.size())
+		stats.Add(&stats.ImageBytesWritten, cw.n)
+
+		if n := atomic.AddUint64(&c.created, 1); n%imageProgressInterval == 0 {
+			parent.getSpec().Logger.Infof("Processed %d images", n)
+		}
+
+		return
 	}
 
 	// Now look in the file cache.
@@ -166,3 +199,23 @@ func (c *imageCache) getOrCreate(
 func newImageCache(fileCache *filecache.Cache, ps *helpers.PathSpec) *imageCache {
 	return &imageCache{fileCache: fileCache, pathSpec: ps, store: make(map[string]*resourceAdapter)}
 }
+
+// unused returns the processed images in the file cache not used in
+// the current build.
+func (c *imageCache) unused() ([]string, error) {
+	names, err := c.fileCache.Unused()
+	if err != nil {
+		return nil, err
+	}
+
+	var unused []string
+	for _, name := range names {
+		// Skip the Exif metadata files, see getImageMetaCacheTargetPath.
+		if strings.HasSuffix(name, ".json") {
+			continue
+		}
+		unused = append(unused, name)
+	}
+
+	return unused, nil
+}
@@ -15,6 +15,7 @@ package images
 
 import (
 	"fmt"
+	"image"
 	"image/color"
 	"strconv"
 	"strings"
@@ -29,6 +30,7 @@ import (
 	"github.com/disintegration/gift"
 
 	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/cast"
 )
 
 var (
@@ -129,6 +131,7 @@ var defaultImaging = Imaging{
 	BgColor:        defaultBgColor,
 	Hint:           defaultHint,
 	Quality:        defaultJPEGQuality,
+	Workers:        1,
 }
 
 func DecodeConfig(m map[string]any) (ImagingConfig, error) {
@@ -197,9 +200,11 @@ func DecodeImageConfig(action, config string, defaults ImagingConfig, sourceForm
 
 		if part == smartCropIdentifier {
 			c.AnchorStr = smartCropIdentifier
+			c.anchorSetForImage = true
 		} else if pos, ok := anchorPositions[part]; ok {
 			c.Anchor = pos
 			c.AnchorStr = part
+			c.anchorSetForImage = true
 		} else if filter, ok := imageFilters[part]; ok {
 			c.Filter = filter
 			c.FilterStr = part
@@ -338,8 +343,88 @@ type ImageConfig struct {
 	Filter    gift.Resampling
 	FilterStr string
 
-	Anchor    gift.Anchor
-	AnchorStr string
+	Anchor            gift.Anchor
+	AnchorStr         string
+	anchorSetForImage bool // Whether the above is set for this image.
+
+	// If set, fill and crop keep this point in the image instead of using
+	// the anchor.
+	FocalPoint *FocalPoint
+}
+
+// FocalPoint is the point of interest in an image, as fractions of the
+// image's width and height from the top left corner, e.g. {0.5, 0.5} for its
+// center.
+type FocalPoint struct {
+	X float64
+	Y float64
+}
+
+// ParseFocalPoint parses a focal point from a slice with the x and y
+// fractions, e.g. [0.3, 0.7].
+func ParseFocalPoint(v any) (FocalPoint, error) {
+	var fp FocalPoint
+	s, err := cast.ToSliceE(v)
+	if err != nil || len(s) != 2 {
+		return fp, fmt.Errorf("invalid focal point %v: must be a slice with x and y, e.g. [0.3, 0.7]", v)
+	}
+	if fp.X, err = cast.ToFloat64E(s[0]); err != nil {
+		return fp, fmt.Errorf("invalid focal point %v: %w", v, err)
+	}
+	if fp.Y, err = cast.ToFloat64E(s[1]); err != nil {
+		return fp, fmt.Errorf("invalid focal point %v: %w", v, err)
+	}
+	if fp.X < 0 || fp.X > 1 || fp.Y < 0 || fp.Y > 1 {
+		return fp, fmt.Errorf("invalid focal point %v: x and y must be between 0 and 1", v)
+	}
+	return fp, nil
+}
+
+// SetFocalPoint makes fill and crop keep fp in the image, unless an anchor
+// is set in the image's spec.
+func (i *ImageConfig) SetFocalPoint(fp FocalPoint) {
+	if i.anchorSetForImage {
+		return
+	}
+	i.FocalPoint = &fp
+}
+
+// bounds returns the bounds of the largest area of the given
+// aspect ratio, or of the given size if no larger, within src that is
+// centered on the focal point, as far as possible.
+func (fp FocalPoint) bounds(src image.Rectangle, width, height int, resize bool) image.Rectangle {
+	srcW, srcH := src.Dx(), src.Dy()
+
+	w, h := width, height
+	if resize {
+		// The largest area with the target aspect ratio.
+		if srcW*height > srcH*width {
+			w, h = srcH*width/height, srcH
+		} else {
+			w, h = srcW, srcW*height/width
+		}
+	}
+	if w > srcW {
+		w = srcW
+	}
+	if h > srcH {
+		h = srcH
+	}
+
+	clamp := func(v, max int) int {
+		if v < 0 {
+			return 0
+		}
+		if v > max {
+			return max
+		}
+		return v
+	}
+
+	x := clamp(int(fp.X*float64(srcW))-w/2, srcW-w)
+	y := clamp(int(fp.Y*float64(srcH))-h/2, srcH-h)
+
+	return image.Rect(src.Min.X+x, src.Min.Y+y, src.Min.X+x+w, src.Min.Y+y+h)
 }
 
 func (i ImageConfig) GetKey(format Format) string {
@@ -367,7 +452,9 @@ func (i ImageConfig) GetKey(format Format) string {
 	}
 
 	anchor := i.AnchorStr
-	if anchor == smartCropIdentifier {
+	if i.FocalPoint != nil {
+		anchor = "fp" + strconv.FormatFloat(i.FocalPoint.X, 'f', -1, 64) + "x" + strconv.FormatFloat(i.FocalPoint.Y, 'f', -1, 64)
+	} else if anchor == smartCropIdentifier {
 		anchor = anchor + strconv.Itoa(smartCropVersionNumber)
 	}
 
@@ -422,6 +509,10 @@ type Imaging struct {
 	// Default color used in fill operations (e.g. "fff" for white).
 	BgColor string
 
+	// The maximum number of images to process in parallel. Default is 1, as
+	// the imaging library spins up its own set of Go routines.
+	Workers int
+
 	Exif ExifConfig
 }
 
@@ -430,6 +521,10 @@ func (cfg *Imaging) init() error {
 		return errors.New("image quality must be a number between 1 and 100")
 	}
 
+	if cfg.Workers < 1 {
+		return errors.New("imaging workers must be a number greater than 0")
+	}
+
 	cfg.BgColor = strings.ToLower(strings.TrimPrefix(cfg.BgColor, "#"))
 	cfg.Anchor = strings.ToLower(cfg.Anchor)
 	cfg.ResampleFilter = strings.ToLower(cfg.ResampleFilter)
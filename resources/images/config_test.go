@@ -15,6 +15,7 @@ package images
 
 import (
 	"fmt"
+	"image"
 	"strings"
 	"testing"
 
@@ -44,12 +45,24 @@ func TestDecodeConfig(t *testing.T) {
 	imaging = imagingConfig.Cfg
 	c.Assert(imaging.ResampleFilter, qt.Equals, "box")
 	c.Assert(imaging.Anchor, qt.Equals, "smart")
+	c.Assert(imaging.Workers, qt.Equals, 1)
 
 	_, err = DecodeConfig(map[string]any{
 		"quality": 123,
 	})
 	c.Assert(err, qt.Not(qt.IsNil))
 
+	imagingConfig, err = DecodeConfig(map[string]any{
+		"workers": 4,
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(imagingConfig.Cfg.Workers, qt.Equals, 4)
+
+	_, err = DecodeConfig(map[string]any{
+		"workers": 0,
+	})
+	c.Assert(err, qt.Not(qt.IsNil))
+
 	_, err = DecodeConfig(map[string]any{
 		"resampleFilter": "asdf",
 	})
@@ -150,9 +163,48 @@ func newImageConfig(action string, width, height, quality, rotate int, filter, a
 			if v, ok := anchorPositions[anchor]; ok {
 				c.Anchor = v
 				c.AnchorStr = anchor
+				c.anchorSetForImage = true
 			}
 		}
 	}
 
 	return c
 }
+
+func TestFocalPoint(t *testing.T) {
+	c := qt.New(t)
+
+	fp, err := ParseFocalPoint([]any{0.25, "0.75"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(fp, qt.Equals, FocalPoint{X: 0.25, Y: 0.75})
+
+	for _, v := range []any{nil, "0.5", []any{0.5}, []any{0.5, 1.5}, []any{-0.1, 0.5}, []any{"a", 0.5}} {
+		_, err := ParseFocalPoint(v)
+		c.Assert(err, qt.IsNotNil, qt.Commentf("%v", v))
+	}
+
+	src := image.Rect(0, 0, 400, 200)
+
+	// Fill: the largest area with the target aspect ratio.
+	c.Assert(FocalPoint{X: 0.5, Y: 0.5}.bounds(src, 100, 100, true), qt.Equals, image.Rect(100, 0, 300, 200))
+	c.Assert(FocalPoint{X: 0.25, Y: 0.5}.bounds(src, 100, 100, true), qt.Equals, image.Rect(0, 0, 200, 200))
+	c.Assert(FocalPoint{X: 0.75, Y: 0.5}.bounds(src, 100, 100, true), qt.Equals, image.Rect(200, 0, 400, 200))
+	c.Assert(FocalPoint{X: 1, Y: 1}.bounds(src, 200, 50, true), qt.Equals, image.Rect(0, 100, 400, 200))
+	c.Assert(FocalPoint{X: 0.5, Y: 0.3}.bounds(src, 200, 50, true), qt.Equals, image.Rect(0, 10, 400, 110))
+
+	// Crop: the target size.
+	c.Assert(FocalPoint{X: 0.1, Y: 0.9}.bounds(src, 100, 100, false), qt.Equals, image.Rect(0, 100, 100, 200))
+	c.Assert(FocalPoint{X: 0.5, Y: 0.5}.bounds(src, 100, 100, false), qt.Equals, image.Rect(150, 50, 250, 150))
+	c.Assert(FocalPoint{X: 0.5, Y: 0.5}.bounds(src, 800, 100, false), qt.Equals, image.Rect(0, 50, 400, 150))
+
+	conf, err := DecodeImageConfig("fill", "100x100", ImagingConfig{}, PNG)
+	c.Assert(err, qt.IsNil)
+	conf.SetFocalPoint(fp)
+	c.Assert(conf.FocalPoint, qt.Not(qt.IsNil))
+	c.Assert(conf.GetKey(PNG), qt.Contains, "_fp0.25x0.75")
+
+	conf, err = DecodeImageConfig("fill", "100x100 TopLeft", ImagingConfig{}, PNG)
+	c.Assert(err, qt.IsNil)
+	conf.SetFocalPoint(fp)
+	c.Assert(conf.FocalPoint, qt.IsNil)
+}
@@ -213,7 +213,9 @@ func (p *ImageProcessor) ApplyFiltersFromConfig(src image.Image, conf ImageConfi
 	case "resize":
 		filters = append(filters, gift.Resize(conf.Width, conf.Height, conf.Filter))
 	case "crop":
-		if conf.AnchorStr == smartCropIdentifier {
+		if conf.FocalPoint != nil {
+			filters = append(filters, gift.Crop(conf.FocalPoint.bounds(src.Bounds(), conf.Width, conf.Height, false)))
+		} else if conf.AnchorStr == smartCropIdentifier {
 			bounds, err := p.smartCrop(src, conf.Width, conf.Height, conf.Filter)
 			if err != nil {
 				return nil, err
@@ -228,7 +230,10 @@ func (p *ImageProcessor) ApplyFiltersFromConfig(src image.Image, conf ImageConfi
 			filters = append(filters, gift.CropToSize(conf.Width, conf.Height, conf.Anchor))
 		}
 	case "fill":
-		if conf.AnchorStr == smartCropIdentifier {
+		if conf.FocalPoint != nil {
+			filters = append(filters, gift.Crop(conf.FocalPoint.bounds(src.Bounds(), conf.Width, conf.Height, true)))
+			filters = append(filters, gift.Resize(conf.Width, conf.Height, conf.Filter))
+		} else if conf.AnchorStr == smartCropIdentifier {
 			bounds, err := p.smartCrop(src, conf.Width, conf.Height, conf.Filter)
 			if err != nil {
 				return nil, err
@@ -14,6 +14,7 @@
 package resources_test
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 
@@ -94,3 +95,179 @@ Width: {{ $svg.Width }}
 	b.Assert(err.Error(), qt.Contains, `error calling Width: this method is only available for raster images. To determine if an image is SVG, you can do {{ if eq .MediaType.SubType "svg" }}{{ end }}`)
 
 }
+
+func TestCDN(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org/blog/"
+[cdn]
+baseURL = "https://cdn.example.org/"
+excludeFiles = ["/sw.js", "**.json"]
+-- assets/css/main.css --
+body { color: red; }
+-- assets/sw.js --
+// Service worker.
+-- assets/data/d.json --
+{}
+-- content/mybundle/index.md --
+---
+title: "My Bundle"
+---
+-- content/mybundle/pixel.png --
+iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYPhfDwAChwGA60e6kgAAAABJRU5ErkJggg==
+-- layouts/index.html --
+{{ $p := site.GetPage "mybundle"}}
+{{ $img := $p.Resources.Get "pixel.png" }}
+{{ $css := resources.Get "css/main.css" | minify | fingerprint }}
+{{ $sw := resources.Get "sw.js" }}
+{{ $json := resources.Get "data/d.json" }}
+img: {{ $img.RelPermalink }}|{{ $img.Permalink }}|
+resized: {{ ($img.Resize "1x1").RelPermalink }}|
+css: {{ $css.RelPermalink }}|
+sw: {{ $sw.RelPermalink }}|{{ $sw.Permalink }}|
+json: {{ $json.RelPermalink }}|
+page: {{ $p.RelPermalink }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		}).Build()
+
+	b.AssertFileContent("public/index.html",
+		"img: https://cdn.example.org/mybundle/pixel.png|https://cdn.example.org/mybundle/pixel.png|",
+		"resized: https://cdn.example.org/mybundle/pixel_hu8aa3346827e49d756ff4e630147c42b5_70_1x1_resize_box_3.png|",
+		"css: https://cdn.example.org/css/main.min.",
+		"sw: /blog/sw.js|https://example.org/blog/sw.js|",
+		"json: /blog/data/d.json|",
+		"page: /blog/mybundle/|",
+	)
+
+	// The resources are still published to the publish directory.
+	b.AssertFileContent("public/mybundle/pixel.png", "")
+	b.AssertFileContent("public/sw.js", "Service worker")
+
+	// The CDN is not used when running the server.
+	b = hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			Running:     true,
+		}).Build()
+
+	b.AssertFileContent("public/index.html",
+		"img: /blog/mybundle/pixel.png|https://example.org/blog/mybundle/pixel.png|",
+		"css: /blog/css/main.min.",
+	)
+}
+
+func TestImageFocalPoint(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+-- content/mybundle/index.md --
+---
+title: "My Bundle"
+resources:
+- src: "pixel.png"
+  params:
+    focalPoint: [0.2, 0.8]
+---
+-- content/mybundle/pixel.png --
+iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYPhfDwAChwGA60e6kgAAAABJRU5ErkJggg==
+-- layouts/index.html --
+{{ $p := site.GetPage "mybundle"}}
+{{ $img := $p.Resources.Get "pixel.png" }}
+fill: {{ ($img.Fill "1x1").RelPermalink }}|
+crop: {{ ($img.Crop "1x1").RelPermalink }}|
+anchor: {{ ($img.Fill "1x1 TopLeft").RelPermalink }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+		}).Build()
+
+	b.AssertFileContent("public/index.html",
+		"fill: /mybundle/pixel_hu8aa3346827e49d756ff4e630147c42b5_70_1x1_fill_box_fp0.2x0.8_3.png|",
+		"crop: /mybundle/pixel_hu8aa3346827e49d756ff4e630147c42b5_70_1x1_crop_box_fp0.2x0.8_3.png|",
+		"anchor: /mybundle/pixel_hu8aa3346827e49d756ff4e630147c42b5_70_1x1_fill_box_topleft_3.png|",
+	)
+}
+
+func TestImageProcessingStats(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+[imaging]
+workers = 2
+-- content/mybundle/index.md --
+---
+title: "My Bundle"
+---
+-- content/mybundle/pixel.png --
+iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYPhfDwAChwGA60e6kgAAAABJRU5ErkJggg==
+-- layouts/index.html --
+{{ $p := site.GetPage "mybundle"}}
+{{ $img := $p.Resources.Get "pixel.png" }}
+{{ ($img.Resize "2x").RelPermalink }}|{{ ($img.Resize "3x").RelPermalink }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+		}).Build()
+
+	stats := b.H.Sites[0].PathSpec.ProcessingStats
+	b.Assert(stats.ProcessedImages, qt.Equals, uint64(2))
+	b.Assert(stats.ImageCacheHits, qt.Equals, uint64(0))
+	b.Assert(stats.ImageBytesRead, qt.Equals, uint64(140))
+	b.Assert(stats.ImageBytesWritten > 0, qt.IsTrue)
+
+	var buf bytes.Buffer
+	b.H.PrintImageStats(&buf)
+	b.Assert(buf.String(), qt.Contains, "Cache hit rate   | 0%")
+
+	unused, err := b.H.UnusedImages()
+	b.Assert(err, qt.IsNil)
+	b.Assert(unused, qt.HasLen, 0)
+}
+
+func TestImageProcessingWorkersSharedByLanguages(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+defaultContentLanguage = "en"
+[languages]
+[languages.en]
+weight = 1
+[languages.nn]
+weight = 2
+-- layouts/index.html --
+Home.
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		}).Build()
+
+	b.Assert(b.H.Sites, qt.HasLen, 2)
+	sem := b.H.Sites[0].ResourceSpec.ImageProcSem
+	b.Assert(cap(sem), qt.Equals, 1)
+	b.Assert(b.H.Sites[1].ResourceSpec.ImageProcSem, qt.Equals, sem)
+}
@@ -17,6 +17,7 @@ package page
 
 import (
 	"html/template"
+	"time"
 
 	"github.com/gohugoio/hugo/identity"
 
@@ -110,6 +111,14 @@ type FileProvider interface {
 	File() source.File
 }
 
+// IDProvider provides a stable page identifier.
+type IDProvider interface {
+	// ID returns a stable identifier for this page, a hash of its language and
+	// its translation key. Unlike the URL, it does not change if the page gets a
+	// new slug or url in front matter.
+	ID() string
+}
+
 // GetPageProvider provides the GetPage method.
 type GetPageProvider interface {
 	// GetPage looks up a page for the given ref.
@@ -195,6 +204,18 @@ type PageMetaProvider interface {
 	// Param looks for a param in Page and then in Site config.
 	Param(key any) (any, error)
 
+	// GetString is the same as Param, but converts the value to a string.
+	GetString(key any) (string, error)
+
+	// GetInt is the same as Param, but converts the value to an int.
+	GetInt(key any) (int, error)
+
+	// GetBool is the same as Param, but converts the value to a bool.
+	GetBool(key any) (bool, error)
+
+	// GetDate is the same as Param, but converts the value to a time.Time.
+	GetDate(key any) (time.Time, error)
+
 	// Path gets the relative path, including file name and extension if relevant,
 	// to the source of this Page. It will be relative to any content root.
 	Path() string
@@ -250,6 +271,8 @@ type PageWithoutContent interface {
 	// For pages backed by a file.
 	FileProvider
 
+	IDProvider
+
 	GitInfoProvider
 
 	// Output formats
@@ -376,6 +399,14 @@ type TranslationsProvider interface {
 // TreeProvider provides section tree navigation.
 type TreeProvider interface {
 
+	// Ancestors returns the ancestors of this page, ordered from the home
+	// page down to the page's parent, following the section tree and bundles.
+	// See Parent.
+	Ancestors() Pages
+
+	// Breadcrumbs returns the Ancestors followed by the page itself.
+	Breadcrumbs() Pages
+
 	// IsAncestor returns whether the current page is an ancestor of the given
 	// Note that this method is not relevant for taxonomy lists and taxonomy terms pages.
 	IsAncestor(other any) (bool, error)
@@ -0,0 +1,200 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package page
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/common/types"
+	"github.com/spf13/cast"
+)
+
+// A Filter is a list of expressions over a Page's fields and params,
+// e.g. "params.internal != true", that must all be true for the Page to match.
+//
+// An expression is either a field, optionally negated with "!", which must be
+// truthy, or a field, an operator and a value:
+//
+//	params.internal != true
+//	section == "docs"
+//	weight > 10
+//	!params.hidden
+//
+// The fields are kind, lang, path, section, type, title, draft and weight
+// and the Page's params, e.g. params.audience or params.product.name.
+// The operators are ==, !=, <, <=, > and >=.
+// The value can be true, false, a number or a string, quoted or not.
+type Filter []filterExpression
+
+var filterFields = map[string]bool{
+	"kind": true, "lang": true, "path": true, "section": true,
+	"type": true, "title": true, "draft": true, "weight": true,
+}
+
+var filterExpressionRe = regexp.MustCompile(`^(!?)\s*([\w.]+)\s*(?:(==|!=|<=|>=|<|>)\s*(.+?))?$`)
+
+// DecodeFilter creates a Filter from a string or a slice of strings.
+func DecodeFilter(v any) (Filter, error) {
+	var f Filter
+	for _, s := range types.ToStringSlicePreserveString(v) {
+		e, err := parseFilterExpression(s)
+		if err != nil {
+			return nil, err
+		}
+		f = append(f, e)
+	}
+	return f, nil
+}
+
+// Matches returns whether p matches all the expressions in this filter.
+func (f Filter) Matches(p Page) bool {
+	for _, e := range f {
+		if !e.matches(p) {
+			return false
+		}
+	}
+	return true
+}
+
+type filterExpression struct {
+	negate bool
+	field  string
+	op     string
+	value  any
+}
+
+func parseFilterExpression(s string) (filterExpression, error) {
+	m := filterExpressionRe.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return filterExpression{}, fmt.Errorf("invalid filter expression %q", s)
+	}
+
+	e := filterExpression{
+		negate: m[1] != "",
+		field:  strings.ToLower(m[2]),
+		op:     m[3],
+	}
+
+	if e.negate && e.op != "" {
+		return e, fmt.Errorf("invalid filter expression %q: negation is only supported without an operator", s)
+	}
+
+	if !strings.HasPrefix(e.field, "params.") && !filterFields[e.field] {
+		return e, fmt.Errorf("invalid filter expression %q: unknown field %q", s, m[2])
+	}
+
+	if e.op != "" {
+		e.value = parseFilterValue(m[4])
+		if _, isNumber := e.value.(float64); !isNumber && e.op != "==" && e.op != "!=" {
+			return e, fmt.Errorf("invalid filter expression %q: %s needs a number", s, e.op)
+		}
+	}
+
+	return e, nil
+}
+
+func parseFilterValue(s string) any {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	if b, err := strconv.ParseBool(s); err == nil {
+		return b
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f
+	}
+	return s
+}
+
+func (e filterExpression) matches(p Page) bool {
+	v := e.fieldValue(p)
+
+	if e.op == "" {
+		return cast.ToBool(v) != e.negate
+	}
+
+	switch want := e.value.(type) {
+	case bool:
+		return e.compare(boolToFloat(cast.ToBool(v)), boolToFloat(want))
+	case float64:
+		got, err := cast.ToFloat64E(v)
+		if err != nil {
+			return e.op == "!="
+		}
+		return e.compare(got, want)
+	default:
+		got := cast.ToString(v)
+		if e.op == "==" {
+			return got == want
+		}
+		return got != want
+	}
+}
+
+func (e filterExpression) compare(got, want float64) bool {
+	switch e.op {
+	case "==":
+		return got == want
+	case "!=":
+		return got != want
+	case "<":
+		return got < want
+	case "<=":
+		return got <= want
+	case ">":
+		return got > want
+	case ">=":
+		return got >= want
+	}
+	return false
+}
+
+func (e filterExpression) fieldValue(p Page) any {
+	if strings.HasPrefix(e.field, "params.") {
+		v, _ := maps.GetNestedParam(strings.TrimPrefix(e.field, "params."), ".", p.Params())
+		return v
+	}
+
+	switch e.field {
+	case "kind":
+		return p.Kind()
+	case "lang":
+		return p.Lang()
+	case "path":
+		return p.Path()
+	case "section":
+		return p.Section()
+	case "type":
+		return p.Type()
+	case "title":
+		return p.Title()
+	case "draft":
+		return p.Draft()
+	case "weight":
+		return p.Weight()
+	}
+
+	return nil
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,70 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package page
+
+import (
+	"testing"
+
+	"github.com/gohugoio/hugo/common/maps"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestFilter(t *testing.T) {
+	c := qt.New(t)
+
+	p1, p2, p3 :=
+		&testPage{kind: "page", section: "docs", weight: 10, params: maps.Params{"internal": true, "product": maps.Params{"name": "pro"}}},
+		&testPage{kind: "page", section: "blog", weight: 20, params: maps.Params{"internal": false}},
+		&testPage{kind: "section", section: "docs", params: maps.Params{}}
+
+	matches := func(v any, p Page) bool {
+		c.Helper()
+		f, err := DecodeFilter(v)
+		c.Assert(err, qt.IsNil)
+		return f.Matches(p)
+	}
+
+	c.Run("Matches", func(c *qt.C) {
+		c.Assert(matches("params.internal != true", p1), qt.IsFalse)
+		c.Assert(matches("params.internal != true", p2), qt.IsTrue)
+		c.Assert(matches("params.internal != true", p3), qt.IsTrue)
+		c.Assert(matches("params.internal == true", p1), qt.IsTrue)
+		c.Assert(matches("params.internal", p1), qt.IsTrue)
+		c.Assert(matches("!params.internal", p1), qt.IsFalse)
+		c.Assert(matches("!params.internal", p3), qt.IsTrue)
+
+		c.Assert(matches(`section == "docs"`, p1), qt.IsTrue)
+		c.Assert(matches(`section == 'docs'`, p2), qt.IsFalse)
+		c.Assert(matches(`section != docs`, p2), qt.IsTrue)
+		c.Assert(matches(`params.product.name == pro`, p1), qt.IsTrue)
+		c.Assert(matches(`params.product.name == pro`, p2), qt.IsFalse)
+
+		c.Assert(matches("weight > 10", p1), qt.IsFalse)
+		c.Assert(matches("weight >= 10", p1), qt.IsTrue)
+		c.Assert(matches("weight < 15", p2), qt.IsFalse)
+		c.Assert(matches("weight<=20", p2), qt.IsTrue)
+
+		c.Assert(matches([]string{"kind == page", "section == docs"}, p1), qt.IsTrue)
+		c.Assert(matches([]string{"kind == page", "section == docs"}, p3), qt.IsFalse)
+		c.Assert(matches(nil, p1), qt.IsTrue)
+	})
+
+	c.Run("Invalid", func(c *qt.C) {
+		for _, s := range []string{"", "params.a ==", "foo == bar", "!params.a == true", "weight > ten", "params.a =~ b"} {
+			_, err := DecodeFilter(s)
+			c.Assert(err, qt.IsNotNil, qt.Commentf(s))
+		}
+	})
+}
@@ -81,6 +81,7 @@ func MarshalPageToJSON(p Page) ([]byte, error) {
 	weight := p.Weight()
 	language := p.Language()
 	file := p.File()
+	iD := p.ID()
 	gitInfo := p.GitInfo()
 	outputFormats := p.OutputFormats()
 	alternativeOutputFormats := p.AlternativeOutputFormats()
@@ -139,6 +140,7 @@ func MarshalPageToJSON(p Page) ([]byte, error) {
 		Weight                   int
 		Language                 *langs.Language
 		File                     source.File
+		ID                       string
 		GitInfo                  *gitmap.GitInfo
 		OutputFormats            OutputFormats
 		AlternativeOutputFormats OutputFormats
@@ -196,6 +198,7 @@ func MarshalPageToJSON(p Page) ([]byte, error) {
 		Weight:                   weight,
 		Language:                 language,
 		File:                     file,
+		ID:                       iD,
 		GitInfo:                  gitInfo,
 		OutputFormats:            outputFormats,
 		AlternativeOutputFormats: alternativeOutputFormats,
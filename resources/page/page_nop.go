@@ -88,10 +88,18 @@ func (p *nopPage) AlternativeOutputFormats() OutputFormats {
 	return nil
 }
 
+func (p *nopPage) Ancestors() Pages {
+	return nil
+}
+
 func (p *nopPage) BaseFileName() string {
 	return ""
 }
 
+func (p *nopPage) Breadcrumbs() Pages {
+	return nil
+}
+
 func (p *nopPage) BundleType() files.ContentClass {
 	return ""
 }
@@ -210,6 +218,10 @@ func (p *nopPage) Hugo() (h hugo.Info) {
 	return
 }
 
+func (p *nopPage) ID() string {
+	return ""
+}
+
 func (p *nopPage) InSection(other any) (bool, error) {
 	return false, nil
 }
@@ -286,6 +298,10 @@ func (p *nopPage) MediaType() (m media.Type) {
 	return
 }
 
+func (p *nopPage) MenuTrail(menuID string) navigation.Menu {
+	return nil
+}
+
 func (p *nopPage) Menus() (m navigation.PageMenus) {
 	return
 }
@@ -326,6 +342,22 @@ func (p *nopPage) Param(key any) (any, error) {
 	return nil, nil
 }
 
+func (p *nopPage) GetString(key any) (string, error) {
+	return "", nil
+}
+
+func (p *nopPage) GetInt(key any) (int, error) {
+	return 0, nil
+}
+
+func (p *nopPage) GetBool(key any) (bool, error) {
+	return false, nil
+}
+
+func (p *nopPage) GetDate(key any) (time.Time, error) {
+	return time.Time{}, nil
+}
+
 func (p *nopPage) Params() maps.Params {
 	return nil
 }
@@ -69,6 +69,10 @@ type TargetPathDescriptor struct {
 
 	// Some types cannot have uglyURLs, even if globally enabled, RSS being one example.
 	UglyURLs bool
+
+	// File name from front matter if set. Will replace the base name and
+	// the suffix of the output format in the target path.
+	Filename string
 }
 
 // TODO(bep) move this type.
@@ -290,6 +294,11 @@ func CreateTargetPaths(d TargetPathDescriptor) (tp TargetPaths) {
 		}
 	}
 
+	if d.Filename != "" {
+		pagePath = path.Join(pagePathDir, d.Filename)
+		link = path.Join(linkDir, d.Filename)
+	}
+
 	pagePath = pjoin(slash, pagePath)
 	pagePathDir = strings.TrimSuffix(path.Join(slash, pagePathDir), slash)
 
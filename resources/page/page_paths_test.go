@@ -276,6 +276,57 @@ func TestPageTargetPathPrefix(t *testing.T) {
 	}
 }
 
+func TestPageTargetPathFilename(t *testing.T) {
+	pathSpec := newTestPathSpec()
+	tests := []struct {
+		name     string
+		d        TargetPathDescriptor
+		expected TargetPaths
+	}{
+		{
+			"JSON home",
+			TargetPathDescriptor{Kind: KindHome, Type: output.JSONFormat, Filename: "manifest.webmanifest"},
+			TargetPaths{TargetFilename: "/manifest.webmanifest", SubResourceBaseTarget: "", SubResourceBaseLink: "", Link: "/manifest.webmanifest"},
+		},
+		{
+			"JSON page",
+			TargetPathDescriptor{Kind: KindPage, Type: output.JSONFormat, Dir: "/a/b", BaseName: "mypage", Filename: "data.json"},
+			TargetPaths{TargetFilename: "/a/b/mypage/data.json", SubResourceBaseTarget: "/a/b/mypage", SubResourceBaseLink: "/a/b/mypage", Link: "/a/b/mypage/data.json"},
+		},
+		{
+			"JSON page, ugly URLs",
+			TargetPathDescriptor{Kind: KindPage, Type: output.JSONFormat, Dir: "/a/b", BaseName: "mypage", UglyURLs: true, Filename: "data.json"},
+			TargetPaths{TargetFilename: "/a/b/mypage/data.json", SubResourceBaseTarget: "/a/b/mypage", SubResourceBaseLink: "/a/b/mypage", Link: "/a/b/mypage/data.json"},
+		},
+		{
+			"JSON page, URL set, prefix both",
+			TargetPathDescriptor{Kind: KindPage, Type: output.JSONFormat, URL: "/mydir/", ForcePrefix: true, PrefixFilePath: "pf", PrefixLink: "pl", Filename: "data.json"},
+			TargetPaths{TargetFilename: "/pf/mydir/data.json", SubResourceBaseTarget: "/pf/mydir", SubResourceBaseLink: "/pl/mydir", Link: "/pl/mydir/data.json"},
+		},
+		{
+			"HTML section list, paginated",
+			TargetPathDescriptor{Kind: KindSection, Type: output.HTMLFormat, Sections: []string{"sect1"}, Addends: "page/2", Filename: "list.html"},
+			TargetPaths{TargetFilename: "/sect1/page/2/list.html", SubResourceBaseTarget: "/sect1/page/2", SubResourceBaseLink: "/sect1/page/2", Link: "/sect1/page/2/list.html"},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.name,
+			func(t *testing.T) {
+				test.d.PathSpec = pathSpec
+				expected := test.expected
+				expected.TargetFilename = filepath.FromSlash(expected.TargetFilename)
+				expected.SubResourceBaseTarget = filepath.FromSlash(expected.SubResourceBaseTarget)
+
+				pagePath := CreateTargetPaths(test.d)
+
+				if pagePath != expected {
+					t.Fatalf("[%d] [%s] targetPath expected\n%#v, got:\n%#v", i, test.name, expected, pagePath)
+				}
+			})
+	}
+}
+
 func eqTargetPaths(p1, p2 TargetPaths) bool {
 	if p1.Link != p2.Link {
 		return false
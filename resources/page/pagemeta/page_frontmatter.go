@@ -58,6 +58,9 @@ type FrontMatterDescriptor struct {
 	// The content file's mod time.
 	ModTime time.Time
 
+	// The newest mod time of the bundle's resources, if any.
+	ResourcesModTime time.Time
+
 	// May be set from the author date in Git.
 	GitAuthorDate time.Time
 
@@ -180,6 +183,9 @@ const (
 	// Gets date from file OS mod time.
 	fmModTime = ":filemodtime"
 
+	// Gets date from the newest OS mod time of the file and its bundle resources.
+	fmBundleModTime = ":bundlemodtime"
+
 	// Gets date from Git
 	fmGitAuthorDate = ":git"
 )
@@ -352,6 +358,8 @@ func (f FrontMatterHandler) createDateHandler(identifiers []string, setter func(
 			handlers = append(handlers, h.newDateFilenameHandler(setter))
 		case fmModTime:
 			handlers = append(handlers, h.newDateModTimeHandler(setter))
+		case fmBundleModTime:
+			handlers = append(handlers, h.newDateBundleModTimeHandler(setter))
 		case fmGitAuthorDate:
 			handlers = append(handlers, h.newDateGitAuthorDateHandler(setter))
 		default:
@@ -416,6 +424,20 @@ func (f *frontmatterFieldHandlers) newDateModTimeHandler(setter func(d *FrontMat
 	}
 }
 
+func (f *frontmatterFieldHandlers) newDateBundleModTimeHandler(setter func(d *FrontMatterDescriptor, t time.Time)) frontMatterFieldHandler {
+	return func(d *FrontMatterDescriptor) (bool, error) {
+		modTime := d.ModTime
+		if d.ResourcesModTime.After(modTime) {
+			modTime = d.ResourcesModTime
+		}
+		if modTime.IsZero() {
+			return false, nil
+		}
+		setter(d, modTime)
+		return true, nil
+	}
+}
+
 func (f *frontmatterFieldHandlers) newDateGitAuthorDateHandler(setter func(d *FrontMatterDescriptor, t time.Time)) frontMatterFieldHandler {
 	return func(d *FrontMatterDescriptor) (bool, error) {
 		if d.GitAuthorDate.IsZero() {
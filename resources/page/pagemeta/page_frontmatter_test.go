@@ -117,7 +117,7 @@ func TestFrontMatterNewConfig(t *testing.T) {
 func TestFrontMatterDatesHandlers(t *testing.T) {
 	c := qt.New(t)
 
-	for _, handlerID := range []string{":filename", ":fileModTime", ":git"} {
+	for _, handlerID := range []string{":filename", ":fileModTime", ":bundleModTime", ":git"} {
 
 		cfg := config.New()
 
@@ -137,6 +137,8 @@ func TestFrontMatterDatesHandlers(t *testing.T) {
 			d.BaseFilename = "2018-02-01-page.md"
 		case ":filemodtime":
 			d.ModTime = d1
+		case ":bundlemodtime":
+			d.ResourcesModTime = d1
 		case ":git":
 			d.GitAuthorDate = d1
 		}
@@ -154,6 +156,33 @@ func TestFrontMatterDatesHandlers(t *testing.T) {
 	}
 }
 
+func TestFrontMatterDatesBundleModTime(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.New()
+	cfg.Set("frontmatter", map[string]any{
+		"lastmod": []string{":bundleModTime"},
+	})
+
+	handler, err := NewFrontmatterHandler(nil, cfg)
+	c.Assert(err, qt.IsNil)
+
+	d1, _ := time.Parse("2006-01-02", "2018-02-01")
+	d2, _ := time.Parse("2006-01-02", "2018-02-02")
+
+	d := newTestFd()
+	d.ModTime = d2
+	d.ResourcesModTime = d1
+	c.Assert(handler.HandleDates(d), qt.IsNil)
+	c.Assert(d.Dates.FLastmod, qt.Equals, d2)
+
+	d = newTestFd()
+	d.ModTime = d1
+	d.ResourcesModTime = d2
+	c.Assert(handler.HandleDates(d), qt.IsNil)
+	c.Assert(d.Dates.FLastmod, qt.Equals, d2)
+}
+
 func TestFrontMatterDatesCustomConfig(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,136 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pagemeta
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gohugoio/hugo/common/htime"
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/spf13/cast"
+)
+
+// FrontMatterViolation describes a well-known front matter field with a
+// value of the wrong type.
+type FrontMatterViolation struct {
+	// The front matter key, lower case.
+	Key string
+
+	// The underlying problem.
+	Err error
+}
+
+func (v FrontMatterViolation) Error() string {
+	return fmt.Sprintf("front matter field %q: %s", v.Key, v.Err)
+}
+
+func (v FrontMatterViolation) Unwrap() error {
+	return v.Err
+}
+
+// ValidateFrontMatter type-checks the well-known fields in the given front matter
+// and returns all violations found, sorted by key.
+// It expects all lower-case keys in the front matter map.
+func (f FrontMatterHandler) ValidateFrontMatter(frontmatter map[string]any, location *time.Location) []FrontMatterViolation {
+	var violations []FrontMatterViolation
+
+	addViolation := func(key string, format string, args ...any) {
+		violations = append(violations, FrontMatterViolation{Key: key, Err: fmt.Errorf(format, args...)})
+	}
+
+	for k, v := range frontmatter {
+		if v == nil {
+			continue
+		}
+		key := strings.ToLower(k)
+
+		if f.IsDateKey(key) {
+			if _, err := htime.ToTimeInDefaultLocationE(v, location); err != nil {
+				addViolation(key, "unable to parse %v as a date", v)
+			}
+			continue
+		}
+
+		switch key {
+		case "title", "linktitle", "summary", "description", "slug", "url", "type", "layout", "markup", "translationkey":
+			if !isScalar(v) {
+				addViolation(key, "expected a string, got %T", v)
+			}
+		case "weight":
+			if _, err := cast.ToIntE(v); err != nil {
+				addViolation(key, "expected a number, got %T (%v)", v, v)
+			}
+		case "draft", "headless", "iscjklanguage":
+			if _, err := cast.ToBoolE(v); err != nil {
+				addViolation(key, "expected a boolean, got %T (%v)", v, v)
+			}
+		case "aliases", "keywords":
+			switch vv := v.(type) {
+			case string:
+			case []string:
+			case []any:
+				for i, vvv := range vv {
+					if _, ok := vvv.(string); !ok {
+						addViolation(key, "expected a list of strings, got %T at index %d", vvv, i)
+						break
+					}
+				}
+			default:
+				addViolation(key, "expected a list of strings, got %T", v)
+			}
+		case "outputs":
+			// The entries are either output format names or maps from
+			// output format names to file names.
+			switch vv := v.(type) {
+			case string:
+			case []string:
+			case []any:
+				for i, vvv := range vv {
+					if _, ok := vvv.(string); ok {
+						continue
+					}
+					if _, err := maps.ToStringMapStringE(vvv); err != nil {
+						addViolation(key, "expected a list of strings or maps, got %T at index %d", vvv, i)
+						break
+					}
+				}
+			default:
+				addViolation(key, "expected a list of strings or maps, got %T", v)
+			}
+		case "sitemap", "_build":
+			switch v.(type) {
+			case maps.Params, map[string]any, map[any]any:
+			default:
+				addViolation(key, "expected a map, got %T", v)
+			}
+		}
+	}
+
+	sort.Slice(violations, func(i, j int) bool {
+		return violations[i].Key < violations[j].Key
+	})
+
+	return violations
+}
+
+func isScalar(v any) bool {
+	switch v.(type) {
+	case string, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, time.Time:
+		return true
+	}
+	return false
+}
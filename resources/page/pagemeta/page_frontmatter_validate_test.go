@@ -0,0 +1,62 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pagemeta
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gohugoio/hugo/config"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestValidateFrontMatter(t *testing.T) {
+	t.Parallel()
+
+	c := qt.New(t)
+
+	handler, err := NewFrontmatterHandler(nil, config.New())
+	c.Assert(err, qt.IsNil)
+
+	violations := handler.ValidateFrontMatter(map[string]any{
+		"title":   "Valid",
+		"weight":  "heavy",
+		"aliases": []any{"/a/", 32},
+		"date":    "not a date",
+		"lastmod": "2021-01-02",
+		"draft":   "maybe",
+		"sitemap": "daily",
+		"layout":  []any{"a", "b"},
+		"foo":     []any{1, 2},
+		"outputs": []any{"html", []any{"json"}},
+	}, time.UTC)
+
+	var keys []string
+	for _, v := range violations {
+		keys = append(keys, v.Key)
+	}
+
+	c.Assert(keys, qt.DeepEquals, []string{"aliases", "date", "draft", "layout", "outputs", "sitemap", "weight"})
+	c.Assert(violations[0].Error(), qt.Equals, `front matter field "aliases": expected a list of strings, got int at index 1`)
+
+	c.Assert(handler.ValidateFrontMatter(map[string]any{
+		"title":   "Valid",
+		"weight":  32,
+		"aliases": []any{"/a/", "/b/"},
+		"date":    "2021-01-02",
+		"draft":   true,
+		"outputs": []any{"html", map[string]any{"json": "data.json"}},
+	}, time.UTC), qt.HasLen, 0)
+}
@@ -26,6 +26,7 @@ import (
 	"errors"
 
 	"github.com/gohugoio/hugo/helpers"
+	"github.com/spf13/cast"
 )
 
 // PermalinkExpander holds permalin mappings per section.
@@ -58,6 +59,16 @@ func (p PermalinkExpander) callback(attr string) (pageToPermaAttribute, bool) {
 		}, true
 	}
 
+	if strings.HasPrefix(attr, "param(") && strings.HasSuffix(attr, ")") {
+		key := strings.TrimSpace(attr[len("param(") : len(attr)-1])
+		if key == "" {
+			return nil, false
+		}
+		return func(pp Page, _ string) (string, error) {
+			return p.pageToPermalinkParam(pp, key)
+		}, true
+	}
+
 	// Make sure this comes after all the other checks.
 	if referenceTime.Format(attr) != attr {
 		return p.pageToPermalinkDate, true
@@ -176,7 +187,7 @@ func (l PermalinkExpander) parse(patterns map[string]string) (map[string]func(Pa
 // can return a string to go in that position in the page (or an error)
 type pageToPermaAttribute func(Page, string) (string, error)
 
-var attributeRegexp = regexp.MustCompile(`:\w+(\[.+\])?`)
+var attributeRegexp = regexp.MustCompile(`:\w+(\[.+?\]|\(.+?\))?`)
 
 // validate determines if a PathPattern is well-formed
 func (l PermalinkExpander) validate(pp string) bool {
@@ -275,6 +286,42 @@ func (l PermalinkExpander) pageToPermalinkSlugElseFilename(p Page, a string) (st
 	return l.pageToPermalinkFilename(p, a)
 }
 
+// pageToPermalinkParam returns the URL-safe form of the given front matter
+// param, falling back to the site params. Slices are joined with a slash.
+func (l PermalinkExpander) pageToPermalinkParam(p Page, key string) (string, error) {
+	v, err := p.Param(key)
+	if err != nil {
+		return "", err
+	}
+
+	var parts []string
+	switch vv := v.(type) {
+	case nil:
+	case string:
+		if vv != "" {
+			parts = []string{vv}
+		}
+	case []string, []any:
+		parts = cast.ToStringSlice(vv)
+	default:
+		s, err := cast.ToStringE(vv)
+		if err != nil {
+			return "", fmt.Errorf("param %q of type %T can not be used in permalink", key, v)
+		}
+		parts = []string{s}
+	}
+
+	if len(parts) == 0 {
+		return "", fmt.Errorf("missing param %q", key)
+	}
+
+	for i, part := range parts {
+		parts[i] = l.ps.URLize(part)
+	}
+
+	return path.Join(parts...), nil
+}
+
 func (l PermalinkExpander) pageToPermalinkSection(p Page, _ string) (string, error) {
 	return p.Section(), nil
 }
@@ -42,6 +42,9 @@ var testdataPermalinks = []struct {
 	{"/:2006_01_02_15_04_05.000", true, "/2012_04_06_03_01_59.000"}, // Complicated custom date format
 	{"/:sections/", true, "/a/b/c/"},                                // Sections
 	{"/:sections[last]/", true, "/c/"},                              // Sections
+	{"/:sections[0:2]/:slug/", true, "/a/b/the-slug/"},              // Sections slice
+	{"/:param(category)/:slug/", true, "/shoes/the-slug/"},          // Param
+	{"/:param(tags)/", true, "/red/running-shoes/"},                 // Param slice
 
 	// Failures
 	{"/blog/:fred", false, ""},
@@ -49,6 +52,7 @@ var testdataPermalinks = []struct {
 	{"/:TITLE", false, ""},      // case is not normalized
 	{"/:2017", false, ""},       // invalid date format
 	{"/:2006-01-02", false, ""}, // valid date format but invalid attribute name
+	{"/:param()/", false, ""},   // missing param name
 }
 
 func TestPermalinkExpansion(t *testing.T) {
@@ -62,13 +66,15 @@ func TestPermalinkExpansion(t *testing.T) {
 	page.date = d
 	page.section = "blue"
 	page.slug = "The Slug"
+	page.params["category"] = "Shoes"
+	page.params["tags"] = []string{"Red", "Running Shoes"}
 
 	for _, item := range testdataPermalinks {
 		if !item.valid {
 			continue
 		}
 
-		specNameCleaner := regexp.MustCompile(`[\:\/\[\]]`)
+		specNameCleaner := regexp.MustCompile(`[\:\/\[\]\(\)]`)
 		name := specNameCleaner.ReplaceAllString(item.spec, "")
 
 		c.Run(name, func(c *qt.C) {
@@ -135,6 +141,25 @@ func TestPermalinkExpansionMultiSection(t *testing.T) {
 	c.Assert(expanded, qt.Equals, "/page-filename")
 }
 
+func TestPermalinkExpansionMissingParam(t *testing.T) {
+	t.Parallel()
+
+	c := qt.New(t)
+
+	page := newTestPageWithFile("/test-page/index.md")
+
+	ps := newTestPathSpec()
+	ps.Cfg.Set("permalinks", map[string]string{
+		"posts": "/:param(category)/:filename/",
+	})
+
+	expander, err := NewPermalinkExpander(ps)
+	c.Assert(err, qt.IsNil)
+
+	_, err = expander.Expand("posts", page)
+	c.Assert(err, qt.ErrorMatches, `.*missing param "category"`)
+}
+
 func TestPermalinkExpansionConcurrent(t *testing.T) {
 	t.Parallel()
 
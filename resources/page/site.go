@@ -68,6 +68,10 @@ type Site interface {
 	// Returns the last modification date of the content.
 	LastChange() time.Time
 
+	// Returns the last modification date of the content.
+	// This is an alias for LastChange.
+	Lastmod() time.Time
+
 	// Returns the Menus for this site.
 	Menus() navigation.Menus
 
@@ -102,6 +106,10 @@ func (t testSite) ServerPort() int {
 	return 1313
 }
 
+func (testSite) Lastmod() (t time.Time) {
+	return
+}
+
 func (testSite) LastChange() (t time.Time) {
 	return
 }
@@ -138,6 +138,10 @@ func (p *testPage) AlternativeOutputFormats() OutputFormats {
 	panic("not implemented")
 }
 
+func (p *testPage) Ancestors() Pages {
+	panic("not implemented")
+}
+
 func (p *testPage) Author() Author {
 	return Author{}
 }
@@ -150,6 +154,10 @@ func (p *testPage) BaseFileName() string {
 	panic("not implemented")
 }
 
+func (p *testPage) Breadcrumbs() Pages {
+	panic("not implemented")
+}
+
 func (p *testPage) BundleType() files.ContentClass {
 	panic("not implemented")
 }
@@ -270,6 +278,10 @@ func (p *testPage) Hugo() hugo.Info {
 	panic("not implemented")
 }
 
+func (p *testPage) ID() string {
+	panic("not implemented")
+}
+
 func (p *testPage) InSection(other any) (bool, error) {
 	panic("not implemented")
 }
@@ -356,6 +368,10 @@ func (p *testPage) MediaType() media.Type {
 	panic("not implemented")
 }
 
+func (p *testPage) MenuTrail(menuID string) navigation.Menu {
+	panic("not implemented")
+}
+
 func (p *testPage) Menus() navigation.PageMenus {
 	return navigation.PageMenus{}
 }
@@ -404,6 +420,22 @@ func (p *testPage) Param(key any) (any, error) {
 	return resource.Param(p, nil, key)
 }
 
+func (p *testPage) GetString(key any) (string, error) {
+	return resource.ParamString(p, nil, key)
+}
+
+func (p *testPage) GetInt(key any) (int, error) {
+	return resource.ParamInt(p, nil, key)
+}
+
+func (p *testPage) GetBool(key any) (bool, error) {
+	return resource.ParamBool(p, nil, key)
+}
+
+func (p *testPage) GetDate(key any) (time.Time, error) {
+	return resource.ParamDate(p, nil, key, time.UTC)
+}
+
 func (p *testPage) Params() maps.Params {
 	return p.params
 }
@@ -156,6 +156,7 @@ type baseResourceInternal interface {
 	tryTransformedFileCache(key string, u *transformationUpdate) io.ReadCloser
 
 	specProvider
+	sizeProvider
 	getResourcePaths() *resourcePathDescriptor
 	getTargetFilenames() []string
 	openDestinationsForWriting() (io.WriteCloser, error)
@@ -168,6 +169,10 @@ type specProvider interface {
 	getSpec() *Spec
 }
 
+type sizeProvider interface {
+	Size() (int64, error)
+}
+
 type baseResource interface {
 	baseResourceResource
 	baseResourceInternal
@@ -254,6 +259,22 @@ func (l *genericResource) cloneTo(targetPath string) resource.Resource {
 
 }
 
+// Size returns the size of the resource in bytes.
+func (l *genericResource) Size() (int64, error) {
+	if l.fi != nil {
+		return l.fi.Size(), nil
+	}
+
+	// Not backed by a file, e.g. a transformed resource.
+	r, err := l.ReadSeekCloser()
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+
+	return r.Seek(0, io.SeekEnd)
+}
+
 func (l *genericResource) Content() (any, error) {
 	if err := l.initContent(); err != nil {
 		return nil, err
@@ -271,10 +292,11 @@ func (l *genericResource) Data() any {
 }
 
 func (l *genericResource) Key() string {
+	relPermalink := l.relPermalinkFor(l.relTargetDirFile.path())
 	if l.spec.BasePath == "" {
-		return l.RelPermalink()
+		return relPermalink
 	}
-	return strings.TrimPrefix(l.RelPermalink(), l.spec.BasePath)
+	return strings.TrimPrefix(relPermalink, l.spec.BasePath)
 }
 
 func (l *genericResource) MediaType() media.Type {
@@ -294,6 +316,9 @@ func (l *genericResource) Params() maps.Params {
 }
 
 func (l *genericResource) Permalink() string {
+	if u, ok := l.spec.CDN.URL(l.Key()); ok {
+		return u
+	}
 	return l.spec.PermalinkForBaseURL(l.relPermalinkForRel(l.relTargetDirFile.path(), true), l.spec.BaseURL.HostURL())
 }
 
@@ -321,6 +346,9 @@ func (l *genericResource) Publish() error {
 }
 
 func (l *genericResource) RelPermalink() string {
+	if u, ok := l.spec.CDN.URL(l.Key()); ok {
+		return u
+	}
 	return l.relPermalinkFor(l.relTargetDirFile.path())
 }
 
@@ -589,6 +617,22 @@ type targetPather interface {
 	TargetPath() string
 }
 
+// TargetPaths returns the paths, relative to the publish dir, r will be
+// published to, one for each language. It returns nil if r is not a
+// Resource created by this package, or if it's transformed.
+func TargetPaths(r resource.Resource) []string {
+	if ra, ok := r.(*resourceAdapter); ok {
+		if len(ra.transformations) > 0 {
+			return nil
+		}
+		r = ra.target
+	}
+	if p, ok := r.(permalinker); ok {
+		return p.relTargetPaths()
+	}
+	return nil
+}
+
 type permalinker interface {
 	targetPather
 	permalinkFor(target string) string
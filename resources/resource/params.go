@@ -14,6 +14,10 @@
 package resource
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/gohugoio/hugo/common/htime"
 	"github.com/gohugoio/hugo/common/maps"
 
 	"github.com/spf13/cast"
@@ -31,3 +35,64 @@ func Param(r ResourceParamsProvider, fallback maps.Params, key any) (any, error)
 
 	return maps.GetNestedParam(keyStr, ".", r.Params(), fallback)
 }
+
+// ParamString is the same as Param, but converts the value to a string.
+// It returns an empty string if the param is not set.
+func ParamString(r ResourceParamsProvider, fallback maps.Params, key any) (string, error) {
+	v, err := Param(r, fallback, key)
+	if err != nil || v == nil {
+		return "", err
+	}
+	s, err := cast.ToStringE(v)
+	if err != nil {
+		return "", paramConvertError(key, "string", err)
+	}
+	return s, nil
+}
+
+// ParamInt is the same as Param, but converts the value to an int.
+// It returns 0 if the param is not set.
+func ParamInt(r ResourceParamsProvider, fallback maps.Params, key any) (int, error) {
+	v, err := Param(r, fallback, key)
+	if err != nil || v == nil {
+		return 0, err
+	}
+	i, err := cast.ToIntE(v)
+	if err != nil {
+		return 0, paramConvertError(key, "int", err)
+	}
+	return i, nil
+}
+
+// ParamBool is the same as Param, but converts the value to a bool.
+// It returns false if the param is not set.
+func ParamBool(r ResourceParamsProvider, fallback maps.Params, key any) (bool, error) {
+	v, err := Param(r, fallback, key)
+	if err != nil || v == nil {
+		return false, err
+	}
+	b, err := cast.ToBoolE(v)
+	if err != nil {
+		return false, paramConvertError(key, "bool", err)
+	}
+	return b, nil
+}
+
+// ParamDate is the same as Param, but converts the value to a time.Time.
+// Dates without a time zone are parsed in loc.
+// It returns the zero time if the param is not set.
+func ParamDate(r ResourceParamsProvider, fallback maps.Params, key any, loc *time.Location) (time.Time, error) {
+	v, err := Param(r, fallback, key)
+	if err != nil || v == nil {
+		return time.Time{}, err
+	}
+	t, err := htime.ToTimeInDefaultLocationE(v, loc)
+	if err != nil {
+		return time.Time{}, paramConvertError(key, "date", err)
+	}
+	return t, nil
+}
+
+func paramConvertError(key any, to string, err error) error {
+	return fmt.Errorf("failed to convert param %q to %s: %w", cast.ToString(key), to, err)
+}
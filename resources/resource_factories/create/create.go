@@ -26,7 +26,7 @@ import (
 
 	"github.com/gohugoio/hugo/hugofs"
 
-	"github.com/gohugoio/hugo/cache/filecache"
+	"github.com/gohugoio/hugo/cache/httpcache"
 	"github.com/gohugoio/hugo/common/hugio"
 	"github.com/gohugoio/hugo/resources"
 	"github.com/gohugoio/hugo/resources/resource"
@@ -35,19 +35,22 @@ import (
 // Client contains methods to create Resource objects.
 // tasks to Resource objects.
 type Client struct {
-	rs               *resources.Spec
-	httpClient       *http.Client
-	cacheGetResource *filecache.Cache
+	rs        *resources.Spec
+	httpCache *httpcache.Client
 }
 
 // New creates a new Client with the given specification.
 func New(rs *resources.Spec) *Client {
 	return &Client{
 		rs: rs,
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
-		cacheGetResource: rs.FileCaches.GetResourceCache(),
+		httpCache: httpcache.New(
+			rs.Cfg,
+			rs.Logger,
+			&http.Client{
+				Timeout: 10 * time.Second,
+			},
+			rs.FileCaches.GetResourceCache(),
+		),
 	}
 }
 
@@ -14,14 +14,12 @@
 package create
 
 import (
-	"bufio"
 	"bytes"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"mime"
 	"net/http"
-	"net/http/httputil"
 	"net/url"
 	"path"
 	"path/filepath"
@@ -82,7 +80,7 @@ func (c *Client) FromRemote(uri string, optionsm map[string]any) (resource.Resou
 
 	resourceID := calculateResourceID(uri, optionsm)
 
-	_, httpResponse, err := c.cacheGetResource.GetOrCreate(resourceID, func() (io.ReadCloser, error) {
+	res, body, err := c.httpCache.Do(resourceID, uri, func() (*http.Request, error) {
 		options, err := decodeRemoteOptions(optionsm)
 		if err != nil {
 			return nil, fmt.Errorf("failed to decode options for resource %s: %w", uri, err)
@@ -101,45 +99,24 @@ func (c *Client) FromRemote(uri string, optionsm map[string]any) (resource.Resou
 			addUserProvidedHeaders(options.Headers, req)
 		}
 
-		res, err := c.httpClient.Do(req)
-		if err != nil {
-			return nil, err
-		}
-
-		httpResponse, err := httputil.DumpResponse(res, true)
-		if err != nil {
-			return nil, toHTTPError(err, res)
-		}
-
+		return req, nil
+	}, func(res *http.Response) error {
 		if res.StatusCode != http.StatusNotFound {
-			if res.StatusCode < 200 || res.StatusCode > 299 {
-				return nil, toHTTPError(fmt.Errorf("failed to fetch remote resource: %s", http.StatusText(res.StatusCode)), res)
-
+			if !c.httpCache.Config.IsAllowedStatusCode(res.StatusCode) {
+				return toHTTPError(fmt.Errorf("failed to fetch remote resource: %s", http.StatusText(res.StatusCode)), res)
 			}
 		}
-
-		return hugio.ToReadCloser(bytes.NewReader(httpResponse)), nil
+		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
-	defer httpResponse.Close()
-
-	res, err := http.ReadResponse(bufio.NewReader(httpResponse), nil)
-	if err != nil {
-		return nil, err
-	}
 
 	if res.StatusCode == http.StatusNotFound {
 		// Not found. This matches how looksup for local resources work.
 		return nil, nil
 	}
 
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read remote resource %q: %w", uri, err)
-	}
-
 	filename := path.Base(rURL.Path)
 	if _, params, _ := mime.ParseMediaType(res.Header.Get("Content-Disposition")); params != nil {
 		if _, ok := params["filename"]; ok {
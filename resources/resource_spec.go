@@ -78,17 +78,24 @@ func NewSpec(
 		return nil, err
 	}
 
+	cdn, err := config.DecodeCDN(s.Cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	rs := &Spec{
 		PathSpec:      s,
 		Logger:        logger,
 		ErrorSender:   errorHandler,
 		imaging:       imaging,
+		ImageProcSem:  make(chan bool, imaging.Cfg.Cfg.Workers),
 		ExecHelper:    execHelper,
 		incr:          incr,
 		MediaTypes:    mimeTypes,
 		OutputFormats: outputFormats,
 		Permalinks:    permalinks,
 		BuildConfig:   config.DecodeBuild(s.Cfg),
+		CDN:           cdn,
 		FileCaches:    fileCaches,
 		PostBuildAssets: &PostBuildAssets{
 			PostProcessResources: make(map[string]postpub.PostPublishedResource),
@@ -120,9 +127,19 @@ type Spec struct {
 	Permalinks  page.PermalinkExpander
 	BuildConfig config.Build
 
+	// Resource URLs are on this CDN if configured.
+	CDN config.CDN
+
 	// Holds default filter settings etc.
 	imaging *images.ImageProcessor
 
+	// Limits the number of images processed in parallel, see imaging.workers.
+	// The imaging library spins up its own set of Go routines, so there is
+	// not much to gain from adding more load to the mix. That can even have
+	// negative effect in low resource scenarios.
+	// This is shared by the languages.
+	ImageProcSem chan bool
+
 	ExecHelper *hexec.Exec
 
 	incr          identity.Incrementer
@@ -163,6 +180,12 @@ func (r *Spec) CacheStats() string {
 	return s
 }
 
+// UnusedImages returns the processed images in the image file cache that are
+// not used in the current build. This requires a full build.
+func (r *Spec) UnusedImages() ([]string, error) {
+	return r.imageCache.unused()
+}
+
 func (r *Spec) ClearCaches() {
 	r.imageCache.clear()
 	r.ResourceCache.clear()
@@ -76,6 +76,10 @@ func TestNewResourceFromFilename(t *testing.T) {
 	c.Assert(err, qt.IsNil)
 	c.Assert(r, qt.Not(qt.IsNil))
 	c.Assert(r.ResourceType(), qt.Equals, "application")
+
+	size, err := r.(sizeProvider).Size()
+	c.Assert(err, qt.IsNil)
+	c.Assert(size, qt.Equals, int64(4))
 }
 
 func TestNewResourceFromFilenameSubPathInBaseURL(t *testing.T) {
@@ -0,0 +1,110 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processor_test
+
+import (
+	"os/exec"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestTransformProcessor(t *testing.T) {
+	if _, err := exec.LookPath("tr"); err != nil {
+		t.Skip("tr not found")
+	}
+
+	c := qt.New(t)
+
+	files := `
+-- config.toml --
+[security.exec]
+allow = ['^tr$']
+[processors.upper]
+command = "tr"
+args = ["a-z", "A-Z"]
+extension = "txt"
+-- assets/a.md --
+hello processor
+-- layouts/index.html --
+{{ $r := resources.Get "a.md" | resources.Process "upper" }}
+Content: {{ $r.Content }}|{{ $r.RelPermalink }}|{{ $r.MediaType }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           c,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html", "Content: HELLO PROCESSOR\n|/a.txt|text/plain|")
+}
+
+func TestTransformProcessorError(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not found")
+	}
+
+	c := qt.New(t)
+
+	files := `
+-- config.toml --
+[security.exec]
+allow = ['^sh$']
+[processors.failing]
+command = "sh"
+args = ["-c", "echo '<stdin>:2:3: unexpected token' >&2; exit 1"]
+-- assets/a.css --
+body {
+  color: blue;
+}
+-- layouts/index.html --
+{{ $r := resources.Get "a.css" | resources.Process "failing" }}
+Content: {{ $r.Content }}
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           c,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.AssertIsFileError(err)
+	c.Assert(err.Error(), qt.Contains, "a.css:2:3")
+	c.Assert(err.Error(), qt.Contains, "unexpected token")
+}
+
+func TestTransformProcessorNotFound(t *testing.T) {
+	c := qt.New(t)
+
+	files := `
+-- config.toml --
+-- assets/a.css --
+body {}
+-- layouts/index.html --
+{{ $r := resources.Get "a.css" | resources.Process "nosuchprocessor" }}
+`
+
+	_, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           c,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	c.Assert(err, qt.ErrorMatches, `(?s).*processor "nosuchprocessor" not found.*`)
+}
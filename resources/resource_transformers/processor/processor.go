@@ -0,0 +1,175 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package processor provides a resource transformation that pipes a resource
+// through an external command configured in the processors section of the
+// site configuration.
+package processor
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/herrors"
+	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/common/hugo"
+	"github.com/gohugoio/hugo/common/loggers"
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/resources"
+	"github.com/gohugoio/hugo/resources/internal"
+	"github.com/gohugoio/hugo/resources/resource"
+	"github.com/mitchellh/mapstructure"
+)
+
+const processorsConfigKey = "processors"
+
+// Processor is an external command that reads the resource content from
+// stdin and writes the transformed content to stdout.
+type Processor struct {
+	// The command to run. This must be allowed in security.exec.allow.
+	Command string
+
+	// The arguments to pass to the command.
+	Args []string
+
+	// The new extension of the transformed resource, e.g. ".css".
+	// Default is to keep the extension.
+	Extension string
+}
+
+// DecodeConfig decodes the processors section of cfg, keyed by the lower case
+// processor name.
+func DecodeConfig(cfg config.Provider) (map[string]Processor, error) {
+	processors := make(map[string]Processor)
+
+	if !cfg.IsSet(processorsConfigKey) {
+		return processors, nil
+	}
+
+	m, err := maps.ToStringMapE(cfg.Get(processorsConfigKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", processorsConfigKey, err)
+	}
+
+	for name, v := range m {
+		var p Processor
+		if err := mapstructure.WeakDecode(v, &p); err != nil {
+			return nil, fmt.Errorf("failed to decode processor %q: %w", name, err)
+		}
+		if p.Command == "" {
+			return nil, fmt.Errorf("processor %q: no command set", name)
+		}
+		if p.Extension != "" && !strings.HasPrefix(p.Extension, ".") {
+			p.Extension = "." + p.Extension
+		}
+		processors[strings.ToLower(name)] = p
+	}
+
+	return processors, nil
+}
+
+// Client is the client used to run external processors.
+type Client struct {
+	rs         *resources.Spec
+	processors map[string]Processor
+}
+
+// New creates a new Client with the processors configured in rs.
+func New(rs *resources.Spec) (*Client, error) {
+	processors, err := DecodeConfig(rs.Cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{rs: rs, processors: processors}, nil
+}
+
+type processorTransformation struct {
+	name      string
+	processor Processor
+	rs        *resources.Spec
+}
+
+func (t *processorTransformation) Key() internal.ResourceTransformationKey {
+	return internal.NewResourceTransformationKey("processor", t.name, t.processor)
+}
+
+// Transform pipes the resource through the processor's command.
+// Anything written to stderr when the command fails is returned as a file
+// error for the source file, with the position if it's in the error message,
+// e.g. "<stdin>:12:5: unexpected token".
+func (t *processorTransformation) Transform(ctx *resources.ResourceTransformationCtx) error {
+	if t.processor.Extension != "" {
+		ctx.ReplaceOutPathExtension(t.processor.Extension)
+		if mt, _, found := t.rs.MediaTypes.GetFirstBySuffix(strings.TrimPrefix(t.processor.Extension, ".")); found {
+			ctx.OutMediaType = mt
+		}
+	}
+
+	var errBuf bytes.Buffer
+	infoW := loggers.LoggerToWriterWithPrefix(t.rs.Logger.Info(), t.name)
+
+	var cmdArgs []any
+	for _, arg := range t.processor.Args {
+		cmdArgs = append(cmdArgs, arg)
+	}
+	cmdArgs = append(cmdArgs,
+		hexec.WithStdin(ctx.From),
+		hexec.WithStdout(ctx.To),
+		hexec.WithStderr(io.MultiWriter(infoW, &errBuf)),
+		hexec.WithDir(t.rs.WorkingDir),
+		hexec.WithEnviron(hugo.GetExecEnviron(t.rs.WorkingDir, t.rs.Cfg, t.rs.BaseFs.Assets.Fs)),
+	)
+
+	cmd, err := t.rs.ExecHelper.New(t.processor.Command, cmdArgs...)
+	if err != nil {
+		if hexec.IsNotFound(err) {
+			// This may be on a CI server etc. Will fall back to pre-built assets.
+			return herrors.ErrFeatureNotAvailable
+		}
+		return err
+	}
+
+	if err := cmd.Run(); err != nil {
+		if hexec.IsNotFound(err) {
+			return herrors.ErrFeatureNotAvailable
+		}
+		return t.toFileError(ctx, errBuf.String(), err)
+	}
+
+	return nil
+}
+
+func (t *processorTransformation) toFileError(ctx *resources.ResourceTransformationCtx, stderr string, err error) error {
+	stderr = strings.TrimSpace(loggers.RemoveANSIColours(stderr))
+	if stderr == "" {
+		return fmt.Errorf("processor %q failed: %w", t.name, err)
+	}
+
+	return herrors.NewFileErrorFromFile(errors.New(stderr), ctx.SourcePath, t.rs.BaseFs.Assets.Fs, nil)
+}
+
+// Process transforms res with the processor with the given name.
+func (c *Client) Process(res resources.ResourceTransformer, name string) (resource.Resource, error) {
+	p, found := c.processors[strings.ToLower(name)]
+	if !found {
+		return nil, fmt.Errorf("processor %q not found in %s config", name, processorsConfigKey)
+	}
+
+	return res.Transform(
+		&processorTransformation{name: name, processor: p, rs: c.rs},
+	)
+}
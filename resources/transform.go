@@ -263,6 +263,14 @@ func (r *resourceAdapter) ResourceType() string {
 	return r.target.ResourceType()
 }
 
+func (r *resourceAdapter) Size() (int64, error) {
+	r.init(false, false)
+	if sp, ok := r.target.(sizeProvider); ok {
+		return sp.Size()
+	}
+	return 0, nil
+}
+
 func (r *resourceAdapter) String() string {
 	return r.Name()
 }
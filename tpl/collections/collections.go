@@ -284,6 +284,10 @@ func (ns *Namespace) In(l any, v any) (bool, error) {
 	lv := reflect.ValueOf(l)
 	vv := reflect.ValueOf(v)
 
+	if eqer, ok := toEqer(vv); ok && (lv.Kind() == reflect.Array || lv.Kind() == reflect.Slice) {
+		return inEqer(eqer, lv), nil
+	}
+
 	vvk := normalize(vv)
 
 	switch lv.Kind() {
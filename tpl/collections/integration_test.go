@@ -73,3 +73,58 @@ Desc: [map[a:3 b:3] map[a:3 b:1] map[a:3 b:1] map[a:3 b:1] map[a:3 b:0] map[a:3
 
 	}
 }
+
+func TestWhereAndInWithPages(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = 'http://example.com/'
+disableKinds = ["taxonomy", "term", "RSS", "sitemap"]
+-- content/s1/_index.md --
+---
+title: S1
+---
+-- content/s1/p1.md --
+---
+title: P1
+---
+{{< current >}}
+-- content/s1/p2.md --
+---
+title: P2
+---
+-- content/s2/p3.md --
+---
+title: P3
+---
+-- layouts/shortcodes/current.html --
+{{ $page := .Page }}{{ range first 1 (where site.RegularPages "Title" "P1") }}Current: {{ in (slice .) $page }}|{{ $page.Eq . }}|{{ eq $page . }}{{ end }}
+-- layouts/_default/single.html --
+{{ .Content }}
+-- layouts/_default/list.html --
+{{ $s1 := site.GetPage "/s1" }}
+Parent eq: {{ range where site.RegularPages "Parent" $s1 }}{{ .Title }}|{{ end }}
+Parent ne: {{ range where site.RegularPages "Parent" "ne" $s1 }}{{ .Title }}|{{ end }}
+Parent in: {{ range where site.RegularPages "Parent" "in" (slice $s1) }}{{ .Title }}|{{ end }}
+Parent not in: {{ range where site.RegularPages "Parent" "not in" (slice $s1) }}{{ .Title }}|{{ end }}
+In: {{ in $s1.Pages (site.GetPage "/s1/p2") }}|{{ in $s1.Pages (site.GetPage "/s2/p3") }}
+  `
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html", `
+Parent eq: P1|P2|
+Parent ne: P3|
+Parent in: P1|P2|
+Parent not in: P3|
+In: true|false
+`)
+
+	b.AssertFileContent("public/s1/p1/index.html", "Current: true|true|true")
+}
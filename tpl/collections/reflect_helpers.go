@@ -19,6 +19,7 @@ import (
 
 	"errors"
 
+	"github.com/gohugoio/hugo/compare"
 	"github.com/mitchellh/hashstructure"
 )
 
@@ -42,6 +43,30 @@ func numberToFloat(v reflect.Value) (float64, error) {
 	}
 }
 
+// toEqer returns v as a compare.Eqer (e.g. a Page) if it implements it.
+func toEqer(v reflect.Value) (compare.Eqer, bool) {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil, false
+	}
+	eqer, ok := v.Interface().(compare.Eqer)
+	return eqer, ok
+}
+
+// inEqer reports whether any of the elements in the slice or array l is
+// equal to eqer.
+func inEqer(eqer compare.Eqer, l reflect.Value) bool {
+	for i := 0; i < l.Len(); i++ {
+		lv, isNil := indirectInterface(l.Index(i))
+		if isNil {
+			continue
+		}
+		if eqer.Eq(lv.Interface()) {
+			return true
+		}
+	}
+	return false
+}
+
 // normalizes different numeric types if isNumber
 // or get the hash values if not Comparable (such as map or struct)
 // to make them comparable
@@ -52,6 +52,22 @@ func (ns *Namespace) Where(seq, key any, args ...any) (any, error) {
 }
 
 func (ns *Namespace) checkCondition(v, mv reflect.Value, op string) (bool, error) {
+	// Values that know how to compare themselves, e.g. pages.
+	if eqer, ok := toEqer(v); ok {
+		switch op {
+		case "", "=", "==", "eq":
+			return mv.IsValid() && mv.CanInterface() && eqer.Eq(mv.Interface()), nil
+		case "!=", "<>", "ne":
+			return !(mv.IsValid() && mv.CanInterface() && eqer.Eq(mv.Interface())), nil
+		case "in", "not in":
+			mv, _ := indirectInterface(mv)
+			if mv.Kind() == reflect.Array || mv.Kind() == reflect.Slice {
+				found := inEqer(eqer, mv)
+				return found == (op == "in"), nil
+			}
+		}
+	}
+
 	v, vIsNil := indirect(v)
 	if !v.IsValid() {
 		vIsNil = true
@@ -67,14 +67,14 @@ func (ns *Namespace) GetCSV(sep string, args ...any) (d [][]string, err error) {
 	url, headers := toURLAndHeaders(args)
 	cache := ns.cacheGetCSV
 
-	unmarshal := func(b []byte) (bool, error) {
+	unmarshal := func(b []byte) error {
 		if d, err = parseCSV(b, sep); err != nil {
 			err = fmt.Errorf("failed to parse CSV file %s: %w", url, err)
 
-			return true, err
+			return err
 		}
 
-		return false, nil
+		return nil
 	}
 
 	var req *http.Request
@@ -112,12 +112,8 @@ func (ns *Namespace) GetJSON(args ...any) (any, error) {
 		return nil, fmt.Errorf("Failed to create request for getJSON resource %s: %w", url, err)
 	}
 
-	unmarshal := func(b []byte) (bool, error) {
-		err := json.Unmarshal(b, &v)
-		if err != nil {
-			return true, err
-		}
-		return false, nil
+	unmarshal := func(b []byte) error {
+		return json.Unmarshal(b, &v)
 	}
 
 	addUserProvidedHeaders(headers, req)
@@ -20,22 +20,17 @@ import (
 	"net/http"
 	"net/url"
 	"path/filepath"
-	"time"
 
 	"github.com/gohugoio/hugo/cache/filecache"
+	"github.com/gohugoio/hugo/cache/httpcache"
 
 	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/helpers"
 	"github.com/spf13/afero"
 )
 
-var (
-	resSleep   = time.Second * 2 // if JSON decoding failed sleep for n seconds before retrying
-	resRetries = 1               // number of retries to load the JSON from URL
-)
-
 // getRemote loads the content of a remote file. This method is thread safe.
-func (ns *Namespace) getRemote(cache *filecache.Cache, unmarshal func([]byte) (bool, error), req *http.Request) error {
+func (ns *Namespace) getRemote(cache *filecache.Cache, unmarshal func([]byte) error, req *http.Request) error {
 	url := req.URL.String()
 	if err := ns.deps.ExecHelper.Sec().CheckAllowedHTTPURL(url); err != nil {
 		return err
@@ -47,53 +42,29 @@ func (ns *Namespace) getRemote(cache *filecache.Cache, unmarshal func([]byte) (b
 	var headers bytes.Buffer
 	req.Header.Write(&headers)
 	id := helpers.MD5String(url + headers.String())
-	var handled bool
-	var retry bool
+	client := httpcache.New(ns.deps.Cfg, ns.deps.Log, ns.client, cache)
 
-	_, b, err := cache.GetOrCreateBytes(id, func() ([]byte, error) {
-		var err error
+	// The client retries failed requests, see config.HTTPCache.
+	var handled bool
+	_, b, err := client.Do(id, url, func() (*http.Request, error) { return req, nil }, func(res *http.Response) error {
 		handled = true
-		for i := 0; i <= resRetries; i++ {
-			ns.deps.Log.Infof("Downloading: %s ...", url)
-			var res *http.Response
-			res, err = ns.client.Do(req)
-			if err != nil {
-				return nil, err
-			}
-
-			var b []byte
-			b, err = ioutil.ReadAll(res.Body)
-			if err != nil {
-				return nil, err
-			}
-			res.Body.Close()
-
-			if isHTTPError(res) {
-				return nil, fmt.Errorf("Failed to retrieve remote file: %s, body: %q", http.StatusText(res.StatusCode), b)
-			}
-
-			retry, err = unmarshal(b)
+		ns.deps.Log.Infof("Downloaded: %s", url)
 
-			if err == nil {
-				// Return it so it can be cached.
-				return b, nil
-			}
-
-			if !retry {
-				return nil, err
-			}
+		b, err := ioutil.ReadAll(res.Body)
+		if err != nil {
+			return err
+		}
 
-			ns.deps.Log.Infof("Cannot read remote resource %s: %s", url, err)
-			ns.deps.Log.Infof("Retry #%d for %s and sleeping for %s", i+1, url, resSleep)
-			time.Sleep(resSleep)
+		if !client.Config.IsAllowedStatusCode(res.StatusCode) {
+			return fmt.Errorf("Failed to retrieve remote file: %s, body: %q", http.StatusText(res.StatusCode), b)
 		}
 
-		return nil, err
+		return unmarshal(b)
 	})
 
-	if !handled {
+	if err == nil && !handled {
 		// This is cached content and should be correct.
-		_, err = unmarshal(b)
+		err = unmarshal(b)
 	}
 
 	return err
@@ -107,7 +78,7 @@ func getLocal(url string, fs afero.Fs, cfg config.Provider) ([]byte, error) {
 
 // getResource loads the content of a local or remote file and returns its content and the
 // cache ID used, if relevant.
-func (ns *Namespace) getResource(cache *filecache.Cache, unmarshal func(b []byte) (bool, error), req *http.Request) error {
+func (ns *Namespace) getResource(cache *filecache.Cache, unmarshal func(b []byte) error, req *http.Request) error {
 	switch req.URL.Scheme {
 	case "":
 		url, err := url.QueryUnescape(req.URL.String())
@@ -118,13 +89,8 @@ func (ns *Namespace) getResource(cache *filecache.Cache, unmarshal func(b []byte
 		if err != nil {
 			return err
 		}
-		_, err = unmarshal(b)
-		return err
+		return unmarshal(b)
 	default:
 		return ns.getRemote(cache, unmarshal, req)
 	}
 }
-
-func isHTTPError(res *http.Response) bool {
-	return res.StatusCode < 200 || res.StatusCode > 299
-}
@@ -115,9 +115,9 @@ func TestScpGetRemote(t *testing.T) {
 		ns.client = cl
 
 		var cb []byte
-		f := func(b []byte) (bool, error) {
+		f := func(b []byte) error {
 			cb = b
-			return false, nil
+			return nil
 		}
 
 		err = ns.getRemote(cache, f, req)
@@ -159,9 +159,9 @@ func TestScpGetRemoteParallel(t *testing.T) {
 				defer wg.Done()
 				for j := 0; j < 10; j++ {
 					var cb []byte
-					f := func(b []byte) (bool, error) {
+					f := func(b []byte) error {
 						cb = b
-						return false, nil
+						return nil
 					}
 					err := ns.getRemote(ns.cacheGetJSON, f, req)
 
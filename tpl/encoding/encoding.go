@@ -15,12 +15,15 @@
 package encoding
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"html/template"
 
+	bp "github.com/gohugoio/hugo/bufferpool"
 	"github.com/gohugoio/hugo/common/maps"
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/cast"
 )
 
@@ -58,6 +61,7 @@ func (ns *Namespace) Base64Encode(content any) (string, error) {
 // "prefix" and "indent".  Each JSON element in the output will begin on a new
 // line beginning with prefix followed by one or more copies of indent according
 // to the indentation nesting.
+// Set the "noHTMLEscape" option to keep <, > and & as is in strings.
 func (ns *Namespace) Jsonify(args ...any) (template.HTML, error) {
 	var (
 		b   []byte
@@ -70,14 +74,28 @@ func (ns *Namespace) Jsonify(args ...any) (template.HTML, error) {
 	case 1:
 		b, err = json.Marshal(args[0])
 	case 2:
-		var opts map[string]string
-
-		opts, err = maps.ToStringMapStringE(args[0])
+		var opts jsonifyOptions
+		var m map[string]any
+		m, err = maps.ToStringMapE(args[0])
 		if err != nil {
 			break
 		}
+		if err = mapstructure.WeakDecode(m, &opts); err != nil {
+			break
+		}
 
-		b, err = json.MarshalIndent(args[1], opts["prefix"], opts["indent"])
+		buf := bp.GetBuffer()
+		defer bp.PutBuffer(buf)
+
+		enc := json.NewEncoder(buf)
+		enc.SetEscapeHTML(!opts.NoHTMLEscape)
+		enc.SetIndent(opts.Prefix, opts.Indent)
+		if err = enc.Encode(args[1]); err != nil {
+			break
+		}
+
+		// Encode adds a newline.
+		b = bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
 	default:
 		err = errors.New("too many arguments to jsonify")
 	}
@@ -88,3 +106,9 @@ func (ns *Namespace) Jsonify(args ...any) (template.HTML, error) {
 
 	return template.HTML(b), nil
 }
+
+type jsonifyOptions struct {
+	Prefix       string
+	Indent       string
+	NoHTMLEscape bool
+}
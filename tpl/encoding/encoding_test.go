@@ -93,6 +93,9 @@ func TestJsonify(t *testing.T) {
 		{map[string]string{"prefix": "<p>", "indent": "<i>"}, []string{"a", "b"}, template.HTML("[\n<p><i>\"a\",\n<p><i>\"b\"\n<p>]")},
 		{nil, tstNoStringer{}, template.HTML("{}")},
 		{nil, nil, template.HTML("null")},
+		{nil, map[string]string{"a": "<b>&"}, template.HTML(`{"a":"\u003cb\u003e\u0026"}`)},
+		{map[string]any{"noHTMLEscape": true}, map[string]string{"a": "<b>&"}, template.HTML(`{"a":"<b>&"}`)},
+		{map[string]any{"noHTMLEscape": "true", "indent": "  "}, []string{"<b>"}, template.HTML("[\n  \"<b>\"\n]")},
 		// errors
 		{nil, math.NaN(), false},
 		{tstNoStringer{}, []string{"a", "b"}, false},
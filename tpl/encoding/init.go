@@ -49,6 +49,7 @@ func init() {
 			[][2]string{
 				{`{{ (slice "A" "B" "C") | jsonify }}`, `["A","B","C"]`},
 				{`{{ (slice "A" "B" "C") | jsonify (dict "indent" "  ") }}`, "[\n  \"A\",\n  \"B\",\n  \"C\"\n]"},
+				{`{{ (slice "A&B") | jsonify (dict "noHTMLEscape" true) }}`, `["A&B"]`},
 			},
 		)
 
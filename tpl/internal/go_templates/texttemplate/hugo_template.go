@@ -157,6 +157,17 @@ func (s *state) evalFunction(dot reflect.Value, node *parse.IdentifierNode, cmd
 	s.at(node)
 	name := node.Ident
 
+	// Added for Hugo.
+	// Stop the execution if the context is done, e.g. on renderTimeout.
+	// Contexts that can never be done have a nil Done channel.
+	if s.ctx != nil && s.ctx.Done() != nil {
+		select {
+		case <-s.ctx.Done():
+			s.errorf("execution of %q stopped: %s", name, s.ctx.Err())
+		default:
+		}
+	}
+
 	var function reflect.Value
 	// Added for Hugo.
 	var first reflect.Value
@@ -93,8 +93,8 @@ type TemplateFuncMethodMapping struct {
 	// Any template funcs aliases. This is mainly motivated by keeping
 	// backwards compatibility, but some new template funcs may also make
 	// sense to give short and snappy aliases.
-	// Note that these aliases are global and will be merged, so the last
-	// key will win.
+	// Note that these aliases are global, as are the namespace names, so
+	// registering the same name twice is an error.
 	Aliases []string
 
 	// A slice of input/expected examples.
@@ -21,6 +21,7 @@ import (
 	"strings"
 	"testing"
 
+	qt "github.com/frankban/quicktest"
 	"github.com/gohugoio/hugo/htesting/hqt"
 	"github.com/gohugoio/hugo/hugolib"
 )
@@ -141,6 +142,64 @@ P2
 `)
 }
 
+func TestIncludeInfiniteRecursion(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = 'http://example.com/'
+-- layouts/index.html --
+{{ partial "p1.html" . }}
+-- layouts/partials/p1.html --
+{{ partial "p2.html" . }}
+-- layouts/partials/p2.html --
+{{ partial "p1.html" . }}
+  `
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, "maximum partial depth (100) exceeded, this is probably an infinite recursion: partials/p1.html:1:3 > partials/p2.html:1:3 > partials/p1.html (cycle of 2 partials)")
+	fe := b.AssertIsFileError(err)
+	b.Assert(fe.Position().LineNumber, qt.Equals, 1)
+}
+
+func TestIncludeInfiniteRecursionChain(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = 'http://example.com/'
+-- layouts/index.html --
+Home.
+{{ partial "p1.html" . }}
+-- layouts/partials/p1.html --
+{{ partial "p2.html" . }}
+-- layouts/partials/p2.html --
+P2.
+{{ if true }}
+  {{ partial "p2.html" . }}
+{{ end }}
+  `
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, "infinite recursion: partials/p1.html:1:3 > partials/p2.html:3:5 > partials/p2.html (partial calls itself)")
+	fe := b.AssertIsFileError(err)
+	b.Assert(fe.Position().LineNumber, qt.Equals, 2)
+}
+
 func TestIncludeCacheHints(t *testing.T) {
 	t.Parallel()
 
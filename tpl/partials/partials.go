@@ -29,6 +29,9 @@ import (
 
 	texttemplate "github.com/gohugoio/hugo/tpl/internal/go_templates/texttemplate"
 
+	"github.com/gohugoio/hugo/common/herrors"
+	"github.com/gohugoio/hugo/common/text"
+
 	"github.com/gohugoio/hugo/helpers"
 
 	"github.com/gohugoio/hugo/tpl"
@@ -37,6 +40,103 @@ import (
 	"github.com/gohugoio/hugo/deps"
 )
 
+// maxPartialDepth is the maximum number of nested partial invocations
+// before we assume we're in an infinite recursion.
+const maxPartialDepth = 100
+
+type partialChainContextKeyType string
+
+// Used to keep track of the currently executing partials.
+const partialChainContextKey = partialChainContextKeyType("partialChain")
+
+// partialRecursionError is returned when maxPartialDepth is exceeded.
+type partialRecursionError struct {
+	// The partials in the call chain, outermost first, ending with
+	// the partial that was not executed.
+	chain []string
+
+	// The position of the partial call in each of the partials in chain,
+	// added as the error travels up the chain.
+	positions []text.Position
+}
+
+func newPartialRecursionError(chain []string) *partialRecursionError {
+	return &partialRecursionError{chain: chain, positions: make([]text.Position, len(chain))}
+}
+
+func (e *partialRecursionError) Error() string {
+	return fmt.Sprintf("maximum partial depth (%d) exceeded, this is probably an infinite recursion: %s", maxPartialDepth, e.formatChain())
+}
+
+// setPosition sets the position of the partial call in the i'th partial in the chain.
+func (e *partialRecursionError) setPosition(i int, pos text.Position) {
+	if i < len(e.positions) {
+		e.positions[i] = pos
+	}
+}
+
+// formatChain formats the call chain up to and including the first round
+// of the cycle it ends in, if any.
+func (e *partialRecursionError) formatChain() string {
+	entry := func(i int) string {
+		if pos := e.positions[i]; pos.IsValid() {
+			return fmt.Sprintf("%s:%d:%d", e.chain[i], pos.LineNumber, pos.ColumnNumber)
+		}
+		return e.chain[i]
+	}
+
+	start, period := findPartialCycle(e.chain)
+	if period == 0 {
+		const max = 10
+		var parts []string
+		for i := 0; i < len(e.chain)-1 && i < max; i++ {
+			parts = append(parts, entry(i))
+		}
+		if len(e.chain) > max+1 {
+			parts = append(parts, "...")
+		}
+		parts = append(parts, e.chain[len(e.chain)-1])
+		return strings.Join(parts, " > ")
+	}
+
+	var parts []string
+	for i := 0; i < start+period; i++ {
+		parts = append(parts, entry(i))
+	}
+	parts = append(parts, e.chain[start])
+
+	cycle := "partial calls itself"
+	if period > 1 {
+		cycle = fmt.Sprintf("cycle of %d partials", period)
+	}
+
+	return fmt.Sprintf("%s (%s)", strings.Join(parts, " > "), cycle)
+}
+
+// findPartialCycle finds the shortest cycle the chain ends in.
+// It returns the index where the cycle starts and its length, 0 if none found.
+func findPartialCycle(chain []string) (start, period int) {
+	n := len(chain)
+	for p := 1; p <= n/2; p++ {
+		repeats := true
+		for j := 0; j < p; j++ {
+			if chain[n-1-j] != chain[n-1-p-j] {
+				repeats = false
+				break
+			}
+		}
+		if !repeats {
+			continue
+		}
+		start = n - 1 - p
+		for start > 0 && chain[start-1] == chain[start-1+p] {
+			start--
+		}
+		return start, p
+	}
+	return 0, 0
+}
+
 // TestTemplateProvider is global deps.ResourceProvider.
 // NOTE: It's currently unused.
 var TestTemplateProvider deps.ResourceProvider
@@ -110,6 +210,11 @@ func (ns *Namespace) Include(ctx context.Context, name string, contextList ...an
 
 	if ns.deps.Metrics != nil {
 		ns.deps.Metrics.TrackValue(name, result, false)
+		var arg any
+		if len(contextList) > 0 {
+			arg = contextList[0]
+		}
+		ns.deps.Metrics.TrackArgsValue(name, arg, result)
 	}
 
 	return result, nil
@@ -140,6 +245,13 @@ func (ns *Namespace) include(ctx context.Context, name string, dataList ...any)
 		return "", "", fmt.Errorf("partial %q not found", name)
 	}
 
+	chain, _ := ctx.Value(partialChainContextKey).([]string)
+	if len(chain) >= maxPartialDepth {
+		return "", "", newPartialRecursionError(append(chain[:len(chain):len(chain)], templ.Name()))
+	}
+	// Clip the slice to make sure we never share the backing array with a sibling.
+	ctx = context.WithValue(ctx, partialChainContextKey, append(chain[:len(chain):len(chain)], templ.Name()))
+
 	var info tpl.ParseInfo
 	if ip, ok := templ.(tpl.Info); ok {
 		info = ip.ParseInfo()
@@ -164,6 +276,15 @@ func (ns *Namespace) include(ctx context.Context, name string, dataList ...any)
 	}
 
 	if err := ns.deps.Tmpl().ExecuteWithContext(ctx, templ, w, data); err != nil {
+		var rerr *partialRecursionError
+		if errors.As(err, &rerr) {
+			// Avoid wrapping the error once per nested partial,
+			// but keep the position of the partial call in this one.
+			if fe := herrors.UnwrapFileError(err); fe != nil {
+				rerr.setPosition(len(chain), fe.Position())
+			}
+			return "", nil, rerr
+		}
 		return "", nil, err
 	}
 
@@ -70,6 +70,11 @@ func init() {
 			[][2]string{},
 		)
 
+		ns.AddMethodMapping(ctx.Process,
+			[]string{},
+			[][2]string{},
+		)
+
 		return ns
 	}
 
@@ -39,6 +39,7 @@ import (
 	"github.com/gohugoio/hugo/resources/resource_transformers/integrity"
 	"github.com/gohugoio/hugo/resources/resource_transformers/minifier"
 	"github.com/gohugoio/hugo/resources/resource_transformers/postcss"
+	"github.com/gohugoio/hugo/resources/resource_transformers/processor"
 	"github.com/gohugoio/hugo/resources/resource_transformers/templates"
 	"github.com/gohugoio/hugo/resources/resource_transformers/tocss/dartsass"
 	"github.com/gohugoio/hugo/resources/resource_transformers/tocss/scss"
@@ -62,6 +63,11 @@ func New(deps *deps.Deps) (*Namespace, error) {
 		return nil, err
 	}
 
+	processorClient, err := processor.New(deps.ResourceSpec)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Namespace{
 		deps:              deps,
 		scssClientLibSass: scssClient,
@@ -72,6 +78,7 @@ func New(deps *deps.Deps) (*Namespace, error) {
 		postcssClient:     postcss.New(deps.ResourceSpec),
 		templatesClient:   templates.New(deps.ResourceSpec, deps),
 		babelClient:       babel.New(deps.ResourceSpec),
+		processorClient:   processorClient,
 	}, nil
 }
 
@@ -88,6 +95,7 @@ type Namespace struct {
 	minifyClient      *minifier.Client
 	postcssClient     *postcss.Client
 	babelClient       *babel.Client
+	processorClient   *processor.Client
 	templatesClient   *templates.Client
 
 	// The Dart Client requires a os/exec process, so  only
@@ -430,3 +438,14 @@ func (ns *Namespace) Babel(args ...any) (resource.Resource, error) {
 
 	return ns.babelClient.Process(r, options)
 }
+
+// Process processes the given Resource with the external processor with the
+// given name, as configured in the processors section of the site configuration.
+func (ns *Namespace) Process(name any, r resources.ResourceTransformer) (resource.Resource, error) {
+	namestr, err := cast.ToStringE(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return ns.processorClient.Process(r, namestr)
+}
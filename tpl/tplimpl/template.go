@@ -127,7 +127,10 @@ func newStandaloneTextTemplate(funcs map[string]any) tpl.TemplateParseFinder {
 }
 
 func newTemplateExec(d *deps.Deps) (*templateExec, error) {
-	exec, funcs := newTemplateExecuter(d)
+	exec, funcs, err := newTemplateExecuter(d)
+	if err != nil {
+		return nil, err
+	}
 	funcMap := make(map[string]any)
 	for k, v := range funcs {
 		funcMap[k] = v.Interface()
@@ -219,7 +222,11 @@ type templateExec struct {
 }
 
 func (t templateExec) Clone(d *deps.Deps) *templateExec {
-	exec, funcs := newTemplateExecuter(d)
+	exec, funcs, err := newTemplateExecuter(d)
+	if err != nil {
+		// The same func map was created without error in newTemplateExec.
+		panic(err)
+	}
 	t.executor = exec
 	t.funcs = funcs
 	t.d = d
@@ -743,9 +750,7 @@ func (t *templateHandler) applyTemplateTransformers(ns *templateNamespace, ts *t
 	return c, err
 }
 
-//go:embed embedded/templates/*
-//go:embed embedded/templates/_default/*
-//go:embed embedded/templates/_server/*
+//go:embed all:embedded/templates
 var embededTemplatesFs embed.FS
 
 func (t *templateHandler) loadEmbedded() error {
@@ -768,7 +773,9 @@ func (t *templateHandler) loadEmbedded() error {
 		// For the render hooks and the server templates it does not make sense to preseve the
 		// double _indternal double book-keeping,
 		// just add it if its now provided by the user.
-		if !strings.Contains(path, "_default/_markup") && !strings.HasPrefix(name, "_server/") {
+		// The same goes for the partials used by the embedded templates,
+		// which need to be named partials/_internal/... to be found by partial.
+		if !strings.Contains(path, "_default/_markup") && !strings.HasPrefix(name, "_server/") && !strings.HasPrefix(name, "partials/_internal/") {
 			templateName = internalPathPrefix + name
 		}
 
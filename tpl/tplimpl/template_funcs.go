@@ -17,7 +17,9 @@ package tplimpl
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/gohugoio/hugo/common/hreflect"
@@ -138,8 +140,11 @@ func (t *templateExecHelper) GetMethod(ctx context.Context, tmpl texttemplate.Pr
 	return fn, zero
 }
 
-func newTemplateExecuter(d *deps.Deps) (texttemplate.Executer, map[string]reflect.Value) {
-	funcs := createFuncMap(d)
+func newTemplateExecuter(d *deps.Deps) (texttemplate.Executer, map[string]reflect.Value, error) {
+	funcs, err := createFuncMap(d)
+	if err != nil {
+		return nil, nil, err
+	}
 	funcsv := make(map[string]reflect.Value)
 
 	for k, v := range funcs {
@@ -171,27 +176,18 @@ func newTemplateExecuter(d *deps.Deps) (texttemplate.Executer, map[string]reflec
 
 	return texttemplate.NewExecuter(
 		exeHelper,
-	), funcsv
+	), funcsv, nil
 }
 
-func createFuncMap(d *deps.Deps) map[string]any {
-	funcMap := template.FuncMap{}
-
-	// Merge the namespace funcs
+func createFuncMap(d *deps.Deps) (map[string]any, error) {
+	var namespaces internal.TemplateFuncsNamespaces
 	for _, nsf := range internal.TemplateFuncsNamespaceRegistry {
-		ns := nsf(d)
-		if _, exists := funcMap[ns.Name]; exists {
-			panic(ns.Name + " is a duplicate template func")
-		}
-		funcMap[ns.Name] = ns.Context
-		for _, mm := range ns.MethodMappings {
-			for _, alias := range mm.Aliases {
-				if _, exists := funcMap[alias]; exists {
-					panic(alias + " is a duplicate template func")
-				}
-				funcMap[alias] = mm.Method
-			}
-		}
+		namespaces = append(namespaces, nsf(d))
+	}
+
+	funcMap, err := createNamespacesFuncMap(namespaces)
+	if err != nil {
+		return nil, err
 	}
 
 	if d.OverloadedTemplateFuncs != nil {
@@ -200,5 +196,44 @@ func createFuncMap(d *deps.Deps) map[string]any {
 		}
 	}
 
-	return funcMap
+	return funcMap, nil
+}
+
+// createNamespacesFuncMap merges the namespaces and their aliases into one
+// func map. Any name registered twice is an error naming both registrants.
+func createNamespacesFuncMap(namespaces internal.TemplateFuncsNamespaces) (map[string]any, error) {
+	funcMap := template.FuncMap{}
+	registrants := make(map[string]string)
+
+	add := func(name, registrant string, fn any) error {
+		if existing, exists := registrants[name]; exists {
+			return fmt.Errorf("template func %q is registered by both %s and %s", name, existing, registrant)
+		}
+		registrants[name] = registrant
+		funcMap[name] = fn
+		return nil
+	}
+
+	for _, ns := range namespaces {
+		if err := add(ns.Name, fmt.Sprintf("namespace %q", ns.Name), ns.Context); err != nil {
+			return nil, err
+		}
+
+		methodNames := make([]string, 0, len(ns.MethodMappings))
+		for name := range ns.MethodMappings {
+			methodNames = append(methodNames, name)
+		}
+		sort.Strings(methodNames)
+
+		for _, methodName := range methodNames {
+			mm := ns.MethodMappings[methodName]
+			for _, alias := range mm.Aliases {
+				if err := add(alias, ns.Name+"."+methodName, mm.Method); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return funcMap, nil
 }
@@ -16,6 +16,7 @@ import (
 	"testing"
 
 	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/tpl/internal"
 )
 
 func TestNeedsBaseTemplate(t *testing.T) {
@@ -38,3 +39,48 @@ func TestNeedsBaseTemplate(t *testing.T) {
 	c.Assert(needsBaseTemplate(`{{/* comment */}}    {{ define "main" }}`), qt.Equals, true)
 	c.Assert(needsBaseTemplate(`     {{/* comment */}}  A  {{ define "main" }}`), qt.Equals, false)
 }
+
+type testFuncsNamespace struct{}
+
+func (testFuncsNamespace) Foo() string { return "foo" }
+func (testFuncsNamespace) Bar() string { return "bar" }
+
+func TestCreateNamespacesFuncMap(t *testing.T) {
+	c := qt.New(t)
+
+	newNamespace := func(name string, fooAliases, barAliases []string) *internal.TemplateFuncsNamespace {
+		var ctx testFuncsNamespace
+		ns := &internal.TemplateFuncsNamespace{
+			Name:    name,
+			Context: func(v ...any) (any, error) { return ctx, nil },
+		}
+		ns.AddMethodMapping(ctx.Foo, fooAliases, nil)
+		ns.AddMethodMapping(ctx.Bar, barAliases, nil)
+		return ns
+	}
+
+	funcMap, err := createNamespacesFuncMap(internal.TemplateFuncsNamespaces{
+		newNamespace("ns1", []string{"foo"}, nil),
+		newNamespace("ns2", nil, []string{"bar"}),
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(funcMap, qt.HasLen, 4)
+
+	_, err = createNamespacesFuncMap(internal.TemplateFuncsNamespaces{
+		newNamespace("ns1", []string{"foo"}, nil),
+		newNamespace("ns2", nil, []string{"foo"}),
+	})
+	c.Assert(err, qt.ErrorMatches, `template func "foo" is registered by both ns1.Foo and ns2.Bar`)
+
+	_, err = createNamespacesFuncMap(internal.TemplateFuncsNamespaces{
+		newNamespace("ns1", []string{"ns2"}, nil),
+		newNamespace("ns2", nil, nil),
+	})
+	c.Assert(err, qt.ErrorMatches, `template func "ns2" is registered by both ns1.Foo and namespace "ns2"`)
+
+	_, err = createNamespacesFuncMap(internal.TemplateFuncsNamespaces{
+		newNamespace("ns1", nil, nil),
+		newNamespace("ns1", nil, nil),
+	})
+	c.Assert(err, qt.ErrorMatches, `template func "ns1" is registered by both namespace "ns1" and namespace "ns1"`)
+}
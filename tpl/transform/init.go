@@ -81,6 +81,16 @@ func init() {
 			},
 		)
 
+		ns.AddMethodMapping(ctx.XMLEscape,
+			nil,
+			[][2]string{
+				{
+					`{{ transform.XMLEscape "Tom & Jerry <tom@example.org> \"TJ\"" | safeHTML }}`,
+					`Tom &amp; Jerry &lt;tom@example.org&gt; &#34;TJ&#34;`,
+				},
+			},
+		)
+
 		ns.AddMethodMapping(ctx.Markdownify,
 			[]string{"markdownify"},
 			[][2]string{
@@ -92,6 +102,14 @@ func init() {
 			[]string{"plainify"},
 			[][2]string{
 				{`{{ plainify  "Hello <strong>world</strong>, gophers!" }}`, `Hello world, gophers!`},
+				{`{{ plainify (dict "removeElements" (slice "sup") "unescape" true) "Fish &amp; Chips<sup>1</sup>" }}`, `Fish &amp; Chips`},
+			},
+		)
+
+		ns.AddMethodMapping(ctx.ShiftHeadings,
+			nil,
+			[][2]string{
+				{`{{ "<h1 id=\"a\">A</h1><h6>B</h6>" | transform.ShiftHeadings 1 }}`, `<h2 id="a">A</h2><h6>B</h6>`},
 			},
 		)
 
@@ -15,17 +15,26 @@
 package transform
 
 import (
+	"encoding/xml"
+	"errors"
+	"fmt"
 	"html"
 	"html/template"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/alecthomas/chroma/v2/lexers"
 	"github.com/gohugoio/hugo/cache/namedmemcache"
+	"github.com/gohugoio/hugo/common/maps"
 	"github.com/gohugoio/hugo/markup/converter/hooks"
 	"github.com/gohugoio/hugo/markup/highlight"
 	"github.com/gohugoio/hugo/tpl"
 
 	"github.com/gohugoio/hugo/deps"
 	"github.com/gohugoio/hugo/helpers"
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/cast"
 )
 
@@ -117,6 +126,24 @@ func (ns *Namespace) HTMLUnescape(s any) (string, error) {
 	return html.UnescapeString(ss), nil
 }
 
+// XMLEscape returns a copy of s with reserved XML characters escaped.
+// Characters not allowed in XML are replaced with the Unicode replacement character.
+// Use it to build XML with plain text output formats, which are not escaped
+// by the template engine.
+func (ns *Namespace) XMLEscape(s any) (string, error) {
+	ss, err := cast.ToStringE(s)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	if err := xml.EscapeText(&b, []byte(ss)); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
 // Markdownify renders s from Markdown to HTML.
 func (ns *Namespace) Markdownify(s any) (template.HTML, error) {
 
@@ -136,13 +163,116 @@ func (ns *Namespace) Markdownify(s any) (template.HTML, error) {
 }
 
 // Plainify returns a copy of s with all HTML tags removed.
-func (ns *Namespace) Plainify(s any) (string, error) {
+// You can optionally provide an options map as the first argument.
+func (ns *Namespace) Plainify(args ...any) (string, error) {
+	if len(args) < 1 || len(args) > 2 {
+		return "", errors.New("plainify takes 1 or 2 arguments")
+	}
+
+	var opts plainifyOptions
+	if len(args) == 2 {
+		m, err := maps.ToStringMapE(args[0])
+		if err != nil {
+			return "", fmt.Errorf("first argument must be a map: %w", err)
+		}
+		if err := mapstructure.WeakDecode(m, &opts); err != nil {
+			return "", fmt.Errorf("failed to decode options: %w", err)
+		}
+	}
+
+	ss, err := cast.ToStringE(args[len(args)-1])
+	if err != nil {
+		return "", err
+	}
+
+	for _, name := range opts.RemoveElements {
+		if !elementNameRe.MatchString(name) {
+			return "", fmt.Errorf("invalid element name %q", name)
+		}
+		ss = removeElementRes.get(name).ReplaceAllString(ss, "")
+	}
+
+	ss = tpl.StripHTML(ss)
+
+	if opts.Unescape {
+		ss = html.UnescapeString(ss)
+	}
+
+	return ss, nil
+}
+
+// plainifyOptions configures what plainify keeps.
+type plainifyOptions struct {
+	// Elements to remove including their content, e.g. ["figure", "sup"].
+	// Note that these cannot be nested.
+	RemoveElements []string
+
+	// Whether to convert HTML entities to plain text, e.g. &amp; to &.
+	// The result must then be escaped again before it's used in HTML,
+	// which Go's html/template does for you.
+	Unescape bool
+}
+
+var elementNameRe = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]*$`)
+
+// elementRegexpCache caches the regexps matching an element including its
+// content, keyed by the lower case element name.
+type elementRegexpCache struct {
+	mu sync.RWMutex
+	re map[string]*regexp.Regexp
+}
+
+func (c *elementRegexpCache) get(name string) *regexp.Regexp {
+	name = strings.ToLower(name)
+
+	c.mu.RLock()
+	re, ok := c.re[name]
+	c.mu.RUnlock()
+	if ok {
+		return re
+	}
+
+	re = regexp.MustCompile(`(?is)<` + name + `\b[^>]*>.*?</` + name + `\s*>`)
+
+	c.mu.Lock()
+	c.re[name] = re
+	c.mu.Unlock()
+
+	return re
+}
+
+var removeElementRes = elementRegexpCache{re: make(map[string]*regexp.Regexp)}
+
+var headingTagRe = regexp.MustCompile(`(?i)<(/?)h([1-6])\b`)
+
+// ShiftHeadings returns a copy of s with the level of all HTML headings
+// shifted by levels, e.g. h1 to h3 with levels set to 2.
+// The levels are capped at h1 and h6.
+func (ns *Namespace) ShiftHeadings(levels any, s any) (template.HTML, error) {
+	n, err := cast.ToIntE(levels)
+	if err != nil {
+		return "", err
+	}
 	ss, err := cast.ToStringE(s)
 	if err != nil {
 		return "", err
 	}
 
-	return tpl.StripHTML(ss), nil
+	if n == 0 {
+		return template.HTML(ss), nil
+	}
+
+	return template.HTML(headingTagRe.ReplaceAllStringFunc(ss, func(tag string) string {
+		m := headingTagRe.FindStringSubmatch(tag)
+		level, _ := strconv.Atoi(m[2])
+		level += n
+		if level < 1 {
+			level = 1
+		} else if level > 6 {
+			level = 6
+		}
+		return "<" + m[1] + "h" + strconv.Itoa(level)
+	})), nil
 }
 
 // For internal use.
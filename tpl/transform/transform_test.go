@@ -138,6 +138,37 @@ func TestHTMLEscape(t *testing.T) {
 	}
 }
 
+func TestXMLEscape(t *testing.T) {
+	t.Parallel()
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{T: t},
+	).Build()
+
+	ns := transform.New(b.H.Deps)
+
+	for _, test := range []struct {
+		s      any
+		expect any
+	}{
+		{`"Foo & Bar's Diner" <y@z>`, `&#34;Foo &amp; Bar&#39;s Diner&#34; &lt;y@z&gt;`},
+		{"a\x00b", "a\uFFFDb"},
+		{42, "42"},
+		// errors
+		{tstNoStringer{}, false},
+	} {
+
+		result, err := ns.XMLEscape(test.s)
+
+		if bb, ok := test.expect.(bool); ok && !bb {
+			b.Assert(err, qt.Not(qt.IsNil))
+			continue
+		}
+
+		b.Assert(err, qt.IsNil)
+		b.Assert(result, qt.Equals, test.expect)
+	}
+}
+
 func TestHTMLUnescape(t *testing.T) {
 	t.Parallel()
 	b := hugolib.NewIntegrationTestBuilder(
@@ -233,16 +264,62 @@ func TestPlainify(t *testing.T) {
 	ns := transform.New(b.H.Deps)
 
 	for _, test := range []struct {
+		opts   any
 		s      any
 		expect any
 	}{
-		{"<em>Note:</em> blah <b>blah</b>", "Note: blah blah"},
-		{"<div data-action='click->my-controller#doThing'>qwe</div>", "qwe"},
+		{nil, "<em>Note:</em> blah <b>blah</b>", "Note: blah blah"},
+		{nil, "<div data-action='click->my-controller#doThing'>qwe</div>", "qwe"},
+		{nil, "<p>Fish &amp; Chips<sup>1</sup></p>", "Fish &amp; Chips1\n"},
+		{map[string]any{"removeElements": []string{"sup"}}, "<p>Fish &amp; Chips<sup id=\"fn1\">1</sup></p>", "Fish &amp; Chips\n"},
+		{map[string]any{"removeElements": []any{"sup", "FIGURE"}, "unescape": true}, "<p>Fish &amp; Chips<sup>1</sup></p><figure><img src=\"a.jpg\"><figcaption>A</figcaption></figure>", "Fish & Chips\n"},
 		// errors
-		{tstNoStringer{}, false},
+		{nil, tstNoStringer{}, false},
+		{"foo", "bar", false},
+		{map[string]any{"removeElements": []string{"a>b"}}, "bar", false},
+	} {
+
+		var result string
+		var err error
+		if test.opts == nil {
+			result, err = ns.Plainify(test.s)
+		} else {
+			result, err = ns.Plainify(test.opts, test.s)
+		}
+
+		if bb, ok := test.expect.(bool); ok && !bb {
+			b.Assert(err, qt.Not(qt.IsNil))
+			continue
+		}
+
+		b.Assert(err, qt.IsNil)
+		b.Assert(result, qt.Equals, test.expect)
+	}
+}
+
+func TestShiftHeadings(t *testing.T) {
+	t.Parallel()
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{T: t},
+	).Build()
+
+	ns := transform.New(b.H.Deps)
+
+	for _, test := range []struct {
+		levels any
+		s      any
+		expect any
+	}{
+		{1, `<h1 id="a">A</h1><p>h1</p><h2>B</h2>`, template.HTML(`<h2 id="a">A</h2><p>h1</p><h3>B</h3>`)},
+		{"2", `<H5>A</H5><h6>B</h6>`, template.HTML(`<h6>A</h6><h6>B</h6>`)},
+		{-1, `<h1>A</h1><h3>B</h3><header>C</header>`, template.HTML(`<h1>A</h1><h2>B</h2><header>C</header>`)},
+		{0, `<h1>A</h1>`, template.HTML(`<h1>A</h1>`)},
+		// errors
+		{"a", `<h1>A</h1>`, false},
+		{1, tstNoStringer{}, false},
 	} {
 
-		result, err := ns.Plainify(test.s)
+		result, err := ns.ShiftHeadings(test.levels, test.s)
 
 		if bb, ok := test.expect.(bool); ok && !bb {
 			b.Assert(err, qt.Not(qt.IsNil))
@@ -0,0 +1,56 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metainject
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+
+	"github.com/gohugoio/hugo/helpers"
+	"github.com/gohugoio/hugo/transform"
+)
+
+var canonicalLinkCheck = regexp.MustCompile(`(?i)<link\s+[^>]*rel=['"]?canonical['"]?`)
+
+// NewCanonicalLink creates a transformer that injects a canonical link
+// to permalink in the head if none present.
+func NewCanonicalLink(permalink string) transform.Transformer {
+	tag := fmt.Sprintf(`<link rel="canonical" href="%s" />`, html.EscapeString(permalink))
+	return func(ft transform.FromTo) error {
+		b := ft.From().Bytes()
+		if !canonicalLinkCheck.Match(b) {
+			b = injectInHead(b, tag)
+		}
+		if _, err := ft.To().Write(b); err != nil {
+			helpers.DistinctWarnLog.Println("Failed to inject canonical link:", err)
+		}
+		return nil
+	}
+}
+
+// injectInHead inserts tag after the first <head> or <HEAD> in b.
+func injectInHead(b []byte, tag string) []byte {
+	for _, head := range []string{"<head>", "<HEAD>"} {
+		if i := bytes.Index(b, []byte(head)); i != -1 {
+			i += len(head)
+			newcontent := make([]byte, 0, len(b)+len(tag)+2)
+			newcontent = append(newcontent, b[:i]...)
+			newcontent = append(newcontent, "\n\t"+tag...)
+			return append(newcontent, b[i:]...)
+		}
+	}
+	return b
+}
@@ -0,0 +1,48 @@
+// Copyright 2022 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metainject
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gohugoio/hugo/transform"
+)
+
+func TestCanonicalLinkInject(t *testing.T) {
+	const link = `<link rel="canonical" href="https://example.org/p1/?a=1&amp;b=2" />`
+	for i, this := range []struct {
+		in     string
+		expect string
+	}{
+		{"<head>\n</head>", "<head>\n\t" + link + "\n</head>"},
+		{"<HEAD>\n</HEAD>", "<HEAD>\n\t" + link + "\n</HEAD>"},
+		{`<head><link rel="canonical" href="/foo/"></head>`, `<head><link rel="canonical" href="/foo/"></head>`},
+		{`<head><LINK href='/foo/' REL='canonical'></head>`, `<head><LINK href='/foo/' REL='canonical'></head>`},
+		{`<head><link rel="stylesheet" href="/canonical.css"></head>`, "<head>\n\t" + link + `<link rel="stylesheet" href="/canonical.css"></head>`},
+		{"", ""},
+		{"</head>", "</head>"},
+	} {
+		in := strings.NewReader(this.in)
+		out := new(bytes.Buffer)
+
+		tr := transform.New(NewCanonicalLink("https://example.org/p1/?a=1&b=2"))
+		tr.Apply(out, in)
+
+		if out.String() != this.expect {
+			t.Errorf("[%d] Expected \n%q got \n%q", i, this.expect, out.String())
+		}
+	}
+}
@@ -15,13 +15,11 @@ package urlreplacers
 
 import "github.com/gohugoio/hugo/transform"
 
-var ar = newAbsURLReplacer()
-
 // NewAbsURLTransformer replaces relative URLs with absolute ones
 // in HTML files, using the baseURL setting.
 func NewAbsURLTransformer(path string) transform.Transformer {
 	return func(ft transform.FromTo) error {
-		ar.replaceInHTML(path, ft)
+		replaceInHTML(path, ft)
 		return nil
 	}
 }
@@ -30,7 +28,7 @@ func NewAbsURLTransformer(path string) transform.Transformer {
 // in XML files, using the baseURL setting.
 func NewAbsURLInXMLTransformer(path string) transform.Transformer {
 	return func(ft transform.FromTo) error {
-		ar.replaceInXML(path, ft)
+		replaceInXML(path, ft)
 		return nil
 	}
 }
@@ -16,245 +16,298 @@ package urlreplacers
 import (
 	"bytes"
 	"io"
-	"unicode"
-	"unicode/utf8"
 
 	"github.com/gohugoio/hugo/transform"
+	"golang.org/x/net/html"
 )
 
-type absurllexer struct {
-	// the source to absurlify
-	content []byte
-	// the target for the new absurlified content
-	w io.Writer
-
-	// path may be set to a "." relative path
-	path []byte
-
-	pos   int // input position
-	start int // item start position
-
-	quotes [][]byte
+// urlAttributes are the HTML attributes holding a single URL.
+var urlAttributes = map[string]bool{
+	"action":     true,
+	"background": true,
+	"cite":       true,
+	"codebase":   true,
+	"data":       true,
+	"formaction": true,
+	"href":       true,
+	"icon":       true,
+	"longdesc":   true,
+	"manifest":   true,
+	"poster":     true,
+	"src":        true,
+	"usemap":     true,
+	"xlink:href": true,
 }
 
-type prefix struct {
-	disabled bool
-	b        []byte
-	f        func(l *absurllexer)
-
-	nextPos int
+// srcsetAttributes are the HTML attributes holding a list of image candidates.
+var srcsetAttributes = map[string]bool{
+	"srcset":      true,
+	"imagesrcset": true,
 }
 
-func (p *prefix) find(bs []byte, start int) bool {
-	if p.disabled {
-		return false
-	}
-
-	if p.nextPos == -1 {
-		idx := bytes.Index(bs[start:], p.b)
-
-		if idx == -1 {
-			p.disabled = true
-			// Find the closest match
-			return false
-		}
+var (
+	cdataStart = []byte("<![CDATA[")
+	cdataEnd   = []byte("]]>")
+	metaURL    = []byte("url=")
+)
 
-		p.nextPos = start + idx + len(p.b)
-	}
+// urlRewriter prefixes the root relative URLs in the URL attributes of the
+// HTML elements with path, which is either the baseURL or a "." relative
+// path. Text, comments and the content of script and style elements are
+// left as is.
+type urlRewriter struct {
+	// the target for the rewritten content
+	w io.Writer
 
-	return true
+	path []byte
 }
 
-func newPrefixState() []*prefix {
-	return []*prefix{
-		{b: []byte("src="), f: checkCandidateBase},
-		{b: []byte("href="), f: checkCandidateBase},
-		{b: []byte("url="), f: checkCandidateBase},
-		{b: []byte("action="), f: checkCandidateBase},
-		{b: []byte("srcset="), f: checkCandidateSrcset},
+// rewriteHTML writes content to w with the URLs in its elements rewritten.
+func (r *urlRewriter) rewriteHTML(content []byte) {
+	z := html.NewTokenizer(bytes.NewReader(content))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			// io.EOF, possibly in the middle of a tag. Keep what's left.
+			r.w.Write(z.Raw())
+			return
+		case html.StartTagToken, html.SelfClosingTagToken:
+			r.rewriteTag(z.Raw())
+		default:
+			r.w.Write(z.Raw())
+		}
 	}
 }
 
-func (l *absurllexer) emit() {
-	l.w.Write(l.content[l.start:l.pos])
-	l.start = l.pos
-}
-
-var (
-	relURLPrefix    = []byte("/")
-	relURLPrefixLen = len(relURLPrefix)
-)
-
-func (l *absurllexer) consumeQuote() []byte {
-	for _, q := range l.quotes {
-		if bytes.HasPrefix(l.content[l.pos:], q) {
-			l.pos += len(q)
-			l.emit()
-			return q
+// rewriteXML writes content to w with the URLs in the HTML embedded in
+// its text and CDATA sections rewritten. The XML elements themselves are
+// left as is.
+func (r *urlRewriter) rewriteXML(content []byte) {
+	z := html.NewTokenizer(bytes.NewReader(content))
+	z.AllowCDATA(true)
+	for {
+		tt := z.Next()
+		raw := z.Raw()
+		switch tt {
+		case html.ErrorToken:
+			r.w.Write(raw)
+			return
+		case html.TextToken:
+			if bytes.HasPrefix(raw, cdataStart) && bytes.HasSuffix(raw, cdataEnd) {
+				r.w.Write(cdataStart)
+				r.rewriteHTML(raw[len(cdataStart) : len(raw)-len(cdataEnd)])
+				r.w.Write(cdataEnd)
+			} else {
+				r.rewriteEscapedHTML(raw)
+			}
+		default:
+			r.w.Write(raw)
 		}
 	}
-	return nil
 }
 
-// handle URLs in src and href.
-func checkCandidateBase(l *absurllexer) {
-	l.consumeQuote()
-
-	if !bytes.HasPrefix(l.content[l.pos:], relURLPrefix) {
+// rewriteEscapedHTML rewrites the entity escaped HTML in text, e.g.
+// &lt;a href=&#34;/foo&#34;&gt;. The text is escaped again only if
+// any URL was rewritten, using the same escapes as Go's templates.
+func (r *urlRewriter) rewriteEscapedHTML(text []byte) {
+	if bytes.IndexByte(text, '&') == -1 {
+		r.w.Write(text)
 		return
 	}
 
-	// check for schemaless URLs
-	posAfter := l.pos + relURLPrefixLen
-	if posAfter >= len(l.content) {
-		return
-	}
-	r, _ := utf8.DecodeRune(l.content[posAfter:])
-	if r == '/' {
-		// schemaless: skip
-		return
-	}
-	if l.pos > l.start {
-		l.emit()
-	}
-	l.pos += relURLPrefixLen
-	l.w.Write(l.path)
-	l.start = l.pos
-}
+	unescaped := []byte(html.UnescapeString(string(text)))
+	var b bytes.Buffer
+	(&urlRewriter{w: &b, path: r.path}).rewriteHTML(unescaped)
 
-func (l *absurllexer) posAfterURL(q []byte) int {
-	if len(q) > 0 {
-		// look for end quote
-		return bytes.Index(l.content[l.pos:], q)
+	if bytes.Equal(b.Bytes(), unescaped) {
+		r.w.Write(text)
+		return
 	}
 
-	return bytes.IndexFunc(l.content[l.pos:], func(r rune) bool {
-		return r == '>' || unicode.IsSpace(r)
-	})
+	io.WriteString(r.w, html.EscapeString(b.String()))
 }
 
-// handle URLs in srcset.
-func checkCandidateSrcset(l *absurllexer) {
-	q := l.consumeQuote()
-	if q == nil {
-		// srcset needs to be quoted.
-		return
+// rewriteTag writes the raw start tag to w, rewriting the values of
+// its URL attributes. Everything else, including the quotes and spacing,
+// is preserved.
+func (r *urlRewriter) rewriteTag(tag []byte) {
+	i := 1
+	for i < len(tag) && !isSpace(tag[i]) && tag[i] != '/' && tag[i] != '>' {
+		i++
 	}
+	tagName := bytes.ToLower(tag[1:i])
 
-	// special case, not frequent (me think)
-	if !bytes.HasPrefix(l.content[l.pos:], relURLPrefix) {
-		return
-	}
+	// Start of the bytes not written yet.
+	start := 0
 
-	// check for schemaless URLs
-	posAfter := l.pos + relURLPrefixLen
-	if posAfter >= len(l.content) {
-		return
-	}
-	r, _ := utf8.DecodeRune(l.content[posAfter:])
-	if r == '/' {
-		// schemaless: skip
-		return
-	}
-
-	posEnd := l.posAfterURL(q)
-
-	// safe guard
-	if posEnd < 0 || posEnd > 2000 {
-		return
-	}
-
-	if l.pos > l.start {
-		l.emit()
-	}
+	for i < len(tag) {
+		for i < len(tag) && (isSpace(tag[i]) || tag[i] == '/') {
+			i++
+		}
 
-	section := l.content[l.pos : l.pos+posEnd+1]
+		nameStart := i
+		for i < len(tag) && !isSpace(tag[i]) && tag[i] != '=' && tag[i] != '/' && tag[i] != '>' {
+			i++
+		}
+		name := tag[nameStart:i]
 
-	fields := bytes.Fields(section)
-	for i, f := range fields {
-		if f[0] == '/' {
-			l.w.Write(l.path)
-			l.w.Write(f[1:])
+		for i < len(tag) && isSpace(tag[i]) {
+			i++
+		}
+		if i >= len(tag) || tag[i] != '=' {
+			if i < len(tag) && tag[i] == '>' {
+				break
+			}
+			if len(name) == 0 {
+				i++
+			}
+			continue
+		}
+		i++
+		for i < len(tag) && isSpace(tag[i]) {
+			i++
+		}
+		if i >= len(tag) {
+			break
+		}
 
+		var valueStart, valueEnd int
+		if q := tag[i]; q == '"' || q == '\'' {
+			valueStart = i + 1
+			idx := bytes.IndexByte(tag[valueStart:], q)
+			if idx == -1 {
+				// Unterminated.
+				break
+			}
+			valueEnd = valueStart + idx
+			i = valueEnd + 1
 		} else {
-			l.w.Write(f)
+			valueStart = i
+			for i < len(tag) && !isSpace(tag[i]) && tag[i] != '>' {
+				i++
+			}
+			valueEnd = i
 		}
 
-		if i < len(fields)-1 {
-			l.w.Write([]byte(" "))
+		value := tag[valueStart:valueEnd]
+		var rewritten []byte
+		switch attr := string(bytes.ToLower(name)); {
+		case urlAttributes[attr]:
+			rewritten = r.rewriteURL(value)
+		case srcsetAttributes[attr]:
+			rewritten = r.rewriteSrcset(value)
+		case attr == "content" && string(tagName) == "meta":
+			rewritten = r.rewriteMetaRefresh(value)
+		}
+
+		if rewritten != nil {
+			r.w.Write(tag[start:valueStart])
+			r.w.Write(rewritten)
+			start = valueEnd
 		}
 	}
 
-	l.pos += len(section)
-	l.start = l.pos
+	r.w.Write(tag[start:])
 }
 
-// main loop
-func (l *absurllexer) replace() {
-	contentLength := len(l.content)
-
-	prefixes := newPrefixState()
+// rewriteURL returns the rewritten URL, or nil if u is not root relative.
+func (r *urlRewriter) rewriteURL(u []byte) []byte {
+	if !isRootRelativeURL(u) {
+		return nil
+	}
+	b := make([]byte, 0, len(r.path)+len(u)-1)
+	b = append(b, r.path...)
+	return append(b, u[1:]...)
+}
 
-	for {
-		if l.pos >= contentLength {
-			break
+// rewriteSrcset rewrites the root relative URLs in a list of image
+// candidates, e.g. "/small.jpg 200w, /big.jpg 700w". It returns nil if
+// there is nothing to rewrite.
+func (r *urlRewriter) rewriteSrcset(v []byte) []byte {
+	var b []byte
+	start := 0
+	expectURL := true
+
+	for i := 0; i < len(v); {
+		if isSpace(v[i]) {
+			i++
+			continue
 		}
-
-		var match *prefix
-
-		for _, p := range prefixes {
-			if !p.find(l.content, l.pos) {
-				continue
-			}
-
-			if match == nil || p.nextPos < match.nextPos {
-				match = p
-			}
+		if v[i] == ',' {
+			expectURL = true
+			i++
+			continue
 		}
 
-		if match == nil {
-			// Done!
-			l.pos = contentLength
-			break
-		} else {
-			l.pos = match.nextPos
-			match.nextPos = -1
-			match.f(l)
+		end := i
+		for end < len(v) && !isSpace(v[end]) {
+			end++
 		}
+		word := v[i:end]
+
+		if expectURL {
+			// A comma directly after the URL ends the candidate.
+			u := bytes.TrimRight(word, ",")
+			if rewritten := r.rewriteURL(u); rewritten != nil {
+				b = append(b, v[start:i]...)
+				b = append(b, rewritten...)
+				start = i + len(u)
+			}
+			expectURL = len(u) < len(word)
+		} else if k := bytes.IndexByte(word, ','); k != -1 {
+			// The descriptor ends at the comma, e.g. "1x,/b.png 2x".
+			expectURL = true
+			i += k + 1
+			continue
+		}
+		i = end
 	}
-	// Done!
-	if l.pos > l.start {
-		l.emit()
+
+	if b == nil {
+		return nil
 	}
+
+	return append(b, v[start:]...)
 }
 
-func doReplace(path string, ct transform.FromTo, quotes [][]byte) {
-	lexer := &absurllexer{
-		content: ct.From().Bytes(),
-		w:       ct.To(),
-		path:    []byte(path),
-		quotes:  quotes,
+// rewriteMetaRefresh rewrites the URL in a meta refresh, e.g.
+// "0; url=/foo/". It returns nil if there is nothing to rewrite.
+func (r *urlRewriter) rewriteMetaRefresh(v []byte) []byte {
+	idx := bytes.Index(bytes.ToLower(v), metaURL)
+	if idx == -1 {
+		return nil
+	}
+	urlStart := idx + len(metaURL)
+	rewritten := r.rewriteURL(v[urlStart:])
+	if rewritten == nil {
+		return nil
 	}
 
-	lexer.replace()
+	b := append([]byte{}, v[:urlStart]...)
+	return append(b, rewritten...)
 }
 
-type absURLReplacer struct {
-	htmlQuotes [][]byte
-	xmlQuotes  [][]byte
+// isRootRelativeURL reports whether b is a URL relative to the server root,
+// e.g. "/img.jpg", but not a protocol-relative URL, e.g. "//cdn/img.jpg".
+func isRootRelativeURL(b []byte) bool {
+	return len(b) > 0 && b[0] == '/' && (len(b) == 1 || b[1] != '/')
 }
 
-func newAbsURLReplacer() *absURLReplacer {
-	return &absURLReplacer{
-		htmlQuotes: [][]byte{[]byte("\""), []byte("'")},
-		xmlQuotes:  [][]byte{[]byte("&#34;"), []byte("&#39;")},
+func isSpace(b byte) bool {
+	switch b {
+	case ' ', '\t', '\n', '\r', '\f':
+		return true
 	}
+	return false
 }
 
-func (au *absURLReplacer) replaceInHTML(path string, ct transform.FromTo) {
-	doReplace(path, ct, au.htmlQuotes)
+func replaceInHTML(path string, ct transform.FromTo) {
+	r := &urlRewriter{w: ct.To(), path: []byte(path)}
+	r.rewriteHTML(ct.From().Bytes())
 }
 
-func (au *absURLReplacer) replaceInXML(path string, ct transform.FromTo) {
-	doReplace(path, ct, au.xmlQuotes)
+func replaceInXML(path string, ct transform.FromTo) {
+	r := &urlRewriter{w: ct.To(), path: []byte(path)}
+	r.rewriteXML(ct.From().Bytes())
 }
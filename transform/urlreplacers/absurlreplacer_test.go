@@ -45,9 +45,9 @@ const (
 
 	// Issue: 816, schemaless links combined with others
 	replaceSchemalessHTML        = `Pre. src='//schemaless' src='/normal'  <a href="//schemaless">Schemaless</a>. <a href="/normal">normal</a>. Post.`
-	replaceSchemalessHTMLCorrect = `Pre. src='//schemaless' src='http://base/normal'  <a href="//schemaless">Schemaless</a>. <a href="http://base/normal">normal</a>. Post.`
-	replaceSchemalessXML         = `Pre. src=&#39;//schemaless&#39; src=&#39;/normal&#39;  <a href=&#39;//schemaless&#39;>Schemaless</a>. <a href=&#39;/normal&#39;>normal</a>. Post.`
-	replaceSchemalessXMLCorrect  = `Pre. src=&#39;//schemaless&#39; src=&#39;http://base/normal&#39;  <a href=&#39;//schemaless&#39;>Schemaless</a>. <a href=&#39;http://base/normal&#39;>normal</a>. Post.`
+	replaceSchemalessHTMLCorrect = `Pre. src='//schemaless' src='/normal'  <a href="//schemaless">Schemaless</a>. <a href="http://base/normal">normal</a>. Post.`
+	replaceSchemalessXML         = `Pre. src=&#39;//schemaless&#39; src=&#39;/normal&#39;  &lt;a href=&#39;//schemaless&#39;&gt;Schemaless&lt;/a&gt;. &lt;a href=&#39;/normal&#39;&gt;normal&lt;/a&gt;. Post.`
+	replaceSchemalessXMLCorrect  = `Pre. src=&#39;//schemaless&#39; src=&#39;/normal&#39;  &lt;a href=&#39;//schemaless&#39;&gt;Schemaless&lt;/a&gt;. &lt;a href=&#39;http://base/normal&#39;&gt;normal&lt;/a&gt;. Post.`
 )
 
 const (
@@ -56,21 +56,23 @@ const (
 	srcsetBasicCorrect          = `Pre. <img srcset="http://base/img/small.jpg 200w, http://base/img/medium.jpg 300w, http://base/img/big.jpg 700w" alt="text" src="http://base/img/foo.jpg">`
 	srcsetSingleQuote           = `Pre. <img srcset='/img/small.jpg 200w, /img/big.jpg 700w' alt="text" src="/img/foo.jpg"> POST.`
 	srcsetSingleQuoteCorrect    = `Pre. <img srcset='http://base/img/small.jpg 200w, http://base/img/big.jpg 700w' alt="text" src="http://base/img/foo.jpg"> POST.`
-	srcsetXMLBasic              = `Pre. <img srcset=&#34;/img/small.jpg 200w, /img/big.jpg 700w&#34; alt=&#34;text&#34; src=&#34;/img/foo.jpg&#34;>`
-	srcsetXMLBasicCorrect       = `Pre. <img srcset=&#34;http://base/img/small.jpg 200w, http://base/img/big.jpg 700w&#34; alt=&#34;text&#34; src=&#34;http://base/img/foo.jpg&#34;>`
-	srcsetXMLSingleQuote        = `Pre. <img srcset=&#34;/img/small.jpg 200w, /img/big.jpg 700w&#34; alt=&#34;text&#34; src=&#34;/img/foo.jpg&#34;>`
-	srcsetXMLSingleQuoteCorrect = `Pre. <img srcset=&#34;http://base/img/small.jpg 200w, http://base/img/big.jpg 700w&#34; alt=&#34;text&#34; src=&#34;http://base/img/foo.jpg&#34;>`
+	srcsetXMLBasic              = `Pre. &lt;img srcset=&#34;/img/small.jpg 200w, /img/big.jpg 700w&#34; alt=&#34;text&#34; src=&#34;/img/foo.jpg&#34;&gt;`
+	srcsetXMLBasicCorrect       = `Pre. &lt;img srcset=&#34;http://base/img/small.jpg 200w, http://base/img/big.jpg 700w&#34; alt=&#34;text&#34; src=&#34;http://base/img/foo.jpg&#34;&gt;`
+	srcsetXMLSingleQuote        = `Pre. &lt;img srcset=&#39;/img/small.jpg 200w, /img/big.jpg 700w&#39; alt=&#39;text&#39; src=&#39;/img/foo.jpg&#39;&gt;`
+	srcsetXMLSingleQuoteCorrect = `Pre. &lt;img srcset=&#39;http://base/img/small.jpg 200w, http://base/img/big.jpg 700w&#39; alt=&#39;text&#39; src=&#39;http://base/img/foo.jpg&#39;&gt;`
 	srcsetVariations            = `Pre.
 Missing start quote: <img srcset=/img/small.jpg 200w, /img/big.jpg 700w" alt="text"> src='/img/foo.jpg'> FOO.
 <img srcset='/img.jpg'>
 schemaless: <img srcset='//img.jpg' src='//basic.jpg'>
 schemaless2: <img srcset="//img.jpg" src="//basic.jpg2> POST
 `
+	srcsetSchemaless        = `Pre. <img srcset="/img/small.jpg 200w, //cdn/img/big.jpg 700w" src="/img/foo.jpg"> <img srcset="//cdn/img/small.jpg 200w, /img/big.jpg 700w">`
+	srcsetSchemalessCorrect = `Pre. <img srcset="http://base/img/small.jpg 200w, //cdn/img/big.jpg 700w" src="http://base/img/foo.jpg"> <img srcset="//cdn/img/small.jpg 200w, http://base/img/big.jpg 700w">`
 )
 
 const (
 	srcsetVariationsCorrect = `Pre.
-Missing start quote: <img srcset=/img/small.jpg 200w, /img/big.jpg 700w" alt="text"> src='http://base/img/foo.jpg'> FOO.
+Missing start quote: <img srcset=http://base/img/small.jpg 200w, /img/big.jpg 700w" alt="text"> src='/img/foo.jpg'> FOO.
 <img srcset='http://base/img.jpg'>
 schemaless: <img srcset='//img.jpg' src='//basic.jpg'>
 schemaless2: <img srcset="//img.jpg" src="//basic.jpg2> POST
@@ -82,14 +84,14 @@ schemaless: &lt;img srcset=&#39;//img.jpg&#39; src=&#39;//basic.jpg&#39;&gt;
 schemaless2: &lt;img srcset=&quot;//img.jpg&quot; src=&quot;//basic.jpg2&gt; POST
 `
 	srcsetXMLVariationsCorrect = `Pre.
-Missing start quote: &lt;img srcset=/img/small.jpg 200w /img/big.jpg 700w&quot; alt=&quot;text&quot;&gt; src=&#39;http://base/img/foo.jpg&#39;&gt; FOO.
+Missing start quote: &lt;img srcset=http://base/img/small.jpg 200w /img/big.jpg 700w&#34; alt=&#34;text&#34;&gt; src=&#39;/img/foo.jpg&#39;&gt; FOO.
 &lt;img srcset=&#39;http://base/img.jpg&#39;&gt;
 schemaless: &lt;img srcset=&#39;//img.jpg&#39; src=&#39;//basic.jpg&#39;&gt;
-schemaless2: &lt;img srcset=&quot;//img.jpg&quot; src=&quot;//basic.jpg2&gt; POST
+schemaless2: &lt;img srcset=&#34;//img.jpg&#34; src=&#34;//basic.jpg2&gt; POST
 `
 
-	relPathVariations        = `PRE. a href="/img/small.jpg" input action="/foo.html" meta url=/redirect/to/page/ POST.`
-	relPathVariationsCorrect = `PRE. a href="../../img/small.jpg" input action="../../foo.html" meta url=../../redirect/to/page/ POST.`
+	relPathVariations        = `PRE. <a href="/img/small.jpg"> <form action="/foo.html"> <meta http-equiv="refresh" content="0; url=/redirect/to/page/"> POST.`
+	relPathVariationsCorrect = `PRE. <a href="../../img/small.jpg"> <form action="../../foo.html"> <meta http-equiv="refresh" content="0; url=../../redirect/to/page/"> POST.`
 
 	testBaseURL = "http://base/"
 )
@@ -112,7 +114,7 @@ var (
 	absURLTests    = append(absURLlBenchTests, append(sanityTests, extraTestsHTML...)...)
 	extraTestsXML  = []test{{replaceSchemalessXML, replaceSchemalessXMLCorrect}}
 	xmlAbsURLTests = append(xmlAbsURLBenchTests, append(sanityTests, extraTestsXML...)...)
-	srcsetTests    = []test{{srcsetBasic, srcsetBasicCorrect}, {srcsetSingleQuote, srcsetSingleQuoteCorrect}, {srcsetVariations, srcsetVariationsCorrect}}
+	srcsetTests    = []test{{srcsetBasic, srcsetBasicCorrect}, {srcsetSingleQuote, srcsetSingleQuoteCorrect}, {srcsetVariations, srcsetVariationsCorrect}, {srcsetSchemaless, srcsetSchemalessCorrect}}
 	srcsetXMLTests = []test{
 		{srcsetXMLBasic, srcsetXMLBasicCorrect},
 		{srcsetXMLSingleQuote, srcsetXMLSingleQuoteCorrect},
@@ -170,6 +172,48 @@ func TestAbsURLUnquoted(t *testing.T) {
 	})
 }
 
+func TestAbsURLOnlyInURLAttributes(t *testing.T) {
+	tr := transform.New(NewAbsURLTransformer(testBaseURL))
+
+	apply(t.Errorf, tr, []test{
+		{
+			content:  `<p>Use href="/foo" or src=/bar.</p><!-- <a href="/comment"> -->`,
+			expected: `<p>Use href="/foo" or src=/bar.</p><!-- <a href="/comment"> -->`,
+		},
+		{
+			content:  `<script>var s = '<img src="/script.png">';</script><style>a { background: url(/style.png) }</style>`,
+			expected: `<script>var s = '<img src="/script.png">';</script><style>a { background: url(/style.png) }</style>`,
+		},
+		{
+			content:  `<IMG SRC = "/a.png" data-src="/lazy.png" title="/not-a-url" srcset="/a.png  1x,/b.png 2x">`,
+			expected: `<IMG SRC = "http://base/a.png" data-src="/lazy.png" title="/not-a-url" srcset="http://base/a.png  1x,http://base/b.png 2x">`,
+		},
+		{
+			content:  `<video poster="/poster.jpg"><source src="/video.mp4"></video><link rel="preload" imagesrcset="/img.jpg 1x">`,
+			expected: `<video poster="http://base/poster.jpg"><source src="http://base/video.mp4"></video><link rel="preload" imagesrcset="http://base/img.jpg 1x">`,
+		},
+		{
+			content:  `<a href="/">Home</a><a href="#top">Top</a><a href="foo/">Relative</a><a href="mailto:a@b">Mail</a>`,
+			expected: `<a href="http://base/">Home</a><a href="#top">Top</a><a href="foo/">Relative</a><a href="mailto:a@b">Mail</a>`,
+		},
+		{
+			content:  `Unterminated: <a href="/foo`,
+			expected: `Unterminated: <a href="/foo`,
+		},
+	})
+}
+
+func TestXMLAbsURLCDATA(t *testing.T) {
+	tr := transform.New(NewAbsURLInXMLTransformer(testBaseURL))
+
+	apply(t.Errorf, tr, []test{
+		{
+			content:  `<item><link>/foo/</link><description><![CDATA[<a href="/foo/">Foo</a> src="/text"]]></description></item>`,
+			expected: `<item><link>/foo/</link><description><![CDATA[<a href="http://base/foo/">Foo</a> src="/text"]]></description></item>`,
+		},
+	})
+}
+
 func TestRelativeURL(t *testing.T) {
 	tr := transform.New(NewAbsURLTransformer(helpers.GetDottedRelativePath(filepath.FromSlash("/post/sub/"))))
 